@@ -96,6 +96,20 @@ app = FastAPI(
 app.include_router(questions_router)
 
 
+@app.middleware("http")
+async def request_id_middleware(request: Request, call_next):
+    """Bind the X-Request-ID passed by the platform service to log records."""
+    request_id = request.headers.get("x-request-id")
+    if not request_id:
+        return await call_next(request)
+
+    with logger.contextualize(request_id=request_id):
+        response = await call_next(request)
+
+    response.headers["X-Request-ID"] = request_id
+    return response
+
+
 # Exception handlers
 @app.exception_handler(BusinessLogicError)
 async def business_logic_error_handler(
@@ -2,108 +2,536 @@ package main
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"log/slog"
-	"net"
 	"net/http"
 	"os"
 	"os/signal"
-	"sync"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/casnerano/snippet-war/internal/app"
+	"github.com/casnerano/snippet-war/internal/auth"
 	app_config "github.com/casnerano/snippet-war/internal/config"
+	"github.com/casnerano/snippet-war/internal/event"
+	"github.com/casnerano/snippet-war/internal/frontend"
+	"github.com/casnerano/snippet-war/internal/health"
+	"github.com/casnerano/snippet-war/internal/interceptor"
+	app_logger "github.com/casnerano/snippet-war/internal/logger"
+	"github.com/casnerano/snippet-war/internal/metrics"
+	"github.com/casnerano/snippet-war/internal/middleware"
+	"github.com/casnerano/snippet-war/internal/ratelimit"
+	"github.com/casnerano/snippet-war/internal/requestid"
+	"github.com/casnerano/snippet-war/internal/tracing"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	content_client "github.com/casnerano/snippet-war/internal/client/content_service"
+	"github.com/casnerano/snippet-war/internal/client/fallback"
+	question_bank "github.com/casnerano/snippet-war/internal/client/question_bank"
+	achievement_handler "github.com/casnerano/snippet-war/internal/handler/achievement"
+	daily_handler "github.com/casnerano/snippet-war/internal/handler/daily"
+	duel_handler "github.com/casnerano/snippet-war/internal/handler/duel"
+	leaderboard_handler "github.com/casnerano/snippet-war/internal/handler/leaderboard"
+	profile_handler "github.com/casnerano/snippet-war/internal/handler/profile"
 	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	session_handler "github.com/casnerano/snippet-war/internal/handler/session"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	achievement_repository "github.com/casnerano/snippet-war/internal/repository/achievement"
+	daily_repository "github.com/casnerano/snippet-war/internal/repository/daily"
+	issue_repository "github.com/casnerano/snippet-war/internal/repository/issue"
+	leaderboard_repository "github.com/casnerano/snippet-war/internal/repository/leaderboard"
+	profile_repository "github.com/casnerano/snippet-war/internal/repository/profile"
+	question_repository "github.com/casnerano/snippet-war/internal/repository/question"
+	session_repository "github.com/casnerano/snippet-war/internal/repository/session"
+	achievement_service "github.com/casnerano/snippet-war/internal/service/achievement"
+	catalog_service "github.com/casnerano/snippet-war/internal/service/catalog"
+	daily_service "github.com/casnerano/snippet-war/internal/service/daily"
+	duel_service "github.com/casnerano/snippet-war/internal/service/duel"
+	leaderboard_service "github.com/casnerano/snippet-war/internal/service/leaderboard"
+	question_pool "github.com/casnerano/snippet-war/internal/service/pool"
+	profile_service "github.com/casnerano/snippet-war/internal/service/profile"
 	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+	session_service "github.com/casnerano/snippet-war/internal/service/session"
+	achievement_desc "github.com/casnerano/snippet-war/pkg/api/v1/achievement"
+	daily_desc "github.com/casnerano/snippet-war/pkg/api/v1/daily"
+	duel_desc "github.com/casnerano/snippet-war/pkg/api/v1/duel"
+	leaderboard_desc "github.com/casnerano/snippet-war/pkg/api/v1/leaderboard"
+	profile_desc "github.com/casnerano/snippet-war/pkg/api/v1/profile"
 	quiz_desc "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+	session_desc "github.com/casnerano/snippet-war/pkg/api/v1/session"
 )
 
 func main() {
+	if err := run(); err != nil {
+		slog.Error("Application stopped with error", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
 	config, err := app_config.Load()
 	if err != nil {
-		log.Fatalf("Failed to load config: %s\n", err)
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if config.PrintRequested() {
+		return config.Print(os.Stdout)
+	}
+
+	logLevel := new(slog.LevelVar)
+	logLevel.Set(config.Logging.Level)
+
+	logger, err := app_logger.New(app_logger.Options{
+		Level:  logLevel,
+		Format: config.Logging.Format,
+		Output: os.Stderr,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
 	}
 
-	listener, err := net.Listen("tcp", config.Server.GRPC.Addr)
+	slog.SetDefault(logger)
+
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+		Exporter:    config.Tracing.Exporter,
+		Endpoint:    config.Tracing.Endpoint,
+		Insecure:    config.Tracing.Insecure,
+		SampleRatio: config.Tracing.SampleRatio,
+		ServiceName: config.Tracing.ServiceName,
+	})
 	if err != nil {
-		log.Fatalf("Failed to listen %s: %s\n", config.Server.GRPC.Addr, err)
+		return fmt.Errorf("failed to setup tracing: %w", err)
 	}
 	defer func() {
-		_ = listener.Close()
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+
+		_ = shutdownTracing(shutdownCtx)
 	}()
 
-	grpcServer := grpc.NewServer()
+	if config.Auth.Telegram.BotToken == "" {
+		slog.Warn("Telegram bot token is not configured in dev mode, all authenticated requests will be rejected")
+	}
+
+	telegramValidator := auth.NewTelegramValidator(
+		config.Auth.Telegram.BotToken,
+		config.Auth.Telegram.InitDataTTL.Duration(),
+	)
+
+	rateLimiter := ratelimit.New(getRateLimitOptions(config))
+
+	gatewayCredentials, err := interceptor.NewGatewayCredentials()
+	if err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			interceptor.RequestID(),
+			interceptor.AccessLog(),
+			interceptor.Metrics(),
+			interceptor.Auth(
+				telegramValidator,
+				"/quiz.Quiz/ListLanguages",
+				"/quiz.Quiz/ListTopics",
+				"/grpc.health.v1.Health/Check",
+			),
+			interceptor.RateLimit(rateLimiter, gatewayCredentials, config.RateLimit.TrustedProxies),
+			interceptor.Validation(),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.StreamRequestID(),
+			interceptor.StreamAccessLog(),
+			interceptor.StreamMetrics(),
+			interceptor.StreamAuth(
+				telegramValidator,
+				"/grpc.health.v1.Health/Watch",
+			),
+			interceptor.StreamRateLimit(rateLimiter, gatewayCredentials, config.RateLimit.TrustedProxies),
+			interceptor.StreamValidation(),
+		),
+	)
+
+	contentServiceClient := getContentServiceClient(ctx, config)
+
+	healthService := health.New(health.Options{
+		Interval: config.Health.CheckInterval.Duration(),
+		Timeout:  config.Health.CheckTimeout.Duration(),
+	})
+
+	questionRepository := question_repository.NewMemory(config.Quiz.QuestionTTL.Duration())
+	issueRepository := issue_repository.NewMemory(config.Quiz.QuestionTTL.Duration())
+	eventBus := event.NewBus()
+
+	leaderboardService := leaderboard_service.New(leaderboard_repository.NewMemory())
+	eventBus.Subscribe(event.AnswerGradedName, leaderboardService.HandleEvent)
+	leaderboardHandler := leaderboard_handler.NewLeaderboard(leaderboardService)
+
+	profileService, closeProfiles, err := getProfileService(ctx, config, healthService)
+	if err != nil {
+		return err
+	}
+	defer closeProfiles()
+
+	eventBus.Subscribe(event.AnswerGradedName, profileService.HandleEvent)
+	profileHandler := profile_handler.NewProfiles(profileService)
+
+	questionPool := question_pool.New(contentServiceClient, question_pool.Options{
+		Size:            config.Quiz.Pool.Size,
+		RefillThreshold: config.Quiz.Pool.RefillThreshold,
+		Concurrency:     config.Quiz.Pool.Concurrency,
+		RefillInterval:  config.Quiz.Pool.RefillInterval.Duration(),
+		SeenTTL:         config.Quiz.Pool.SeenTTL.Duration(),
+	})
+
+	questionBank, err := getQuestionBank(config.Quiz.QuestionBank.Dir)
+	if err != nil {
+		return fmt.Errorf("failed to load question bank: %w", err)
+	}
+
+	// The bank serves questions while the content service is down, so the
+	// service stays ready as long as the bank is not empty.
+	healthService.RegisterWithFallback("content_service", health.CheckerFunc(contentServiceClient.Health), questionBank)
+
+	contentProvider := fallback.New(questionPool, questionBank)
 
-	contentServiceClient := getContentServiceClient(ctx, config.ContentService.Addr)
-	quizHandler := getQuizHandler(contentServiceClient)
+	catalogService, err := catalog_service.New()
+	if err != nil {
+		return fmt.Errorf("failed to load catalog: %w", err)
+	}
+
+	quizService := quiz_service.New(contentProvider, questionRepository, issueRepository, catalogService, eventBus, getQuizOptions(config))
+	quizHandler := quiz_handler.NewQuiz(quizService, catalogService)
+
+	duelService := duel_service.New(quizService, catalogService, eventBus, getDuelOptions(config))
+	arenaHandler := duel_handler.NewArena(duelService)
+
+	// Without the daily challenge server its gateway routes answer with
+	// Unimplemented.
+	if config.Daily.Enabled {
+		dailyRepository := daily_repository.NewMemory()
+		dailyService := daily_service.New(questionBank, catalogService, quizService, dailyRepository, eventBus, getDailyOptions(config))
+		daily_desc.RegisterDailyChallengeServer(grpcServer, daily_handler.NewDailyChallenge(dailyService))
+	}
+
+	cors := middleware.NewCORS(getCORSOptions(config))
+
+	configWatcher := app_config.NewWatcher(config)
+	configWatcher.Subscribe(func(config *app_config.Config) {
+		logLevel.Set(config.Logging.Level)
+		cors.SetOptions(getCORSOptions(config))
+		contentServiceClient.SetOptions(getContentServiceOptions(config))
+		quizService.SetOptions(getQuizOptions(config))
+		rateLimiter.SetOptions(getRateLimitOptions(config))
+	})
+
+	achievementRules, err := achievement_service.LoadRules(config.Achievements.RulesFile)
+	if err != nil {
+		return fmt.Errorf("failed to load achievement rules: %w", err)
+	}
+
+	achievementService := achievement_service.New(achievementRules, achievement_repository.NewMemory(), eventBus)
+	for _, name := range []string{
+		event.AnswerGradedName,
+		event.SessionFinishedName,
+		event.StreakExtendedName,
+		event.DuelFinishedName,
+	} {
+		eventBus.Subscribe(name, achievementService.HandleEvent)
+	}
+	eventBus.Subscribe(event.AchievementUnlockedName, func(_ context.Context, e event.Event) {
+		if unlocked, ok := e.(event.AchievementUnlocked); ok {
+			metrics.AchievementUnlocked(unlocked.Achievement.Rule.ID)
+		}
+	})
+	achievementHandler := achievement_handler.NewAchievements(achievementService)
+
+	sessionRepository := session_repository.NewMemory(config.Quiz.SessionTTL.Duration())
+	sessionHandler := getSessionHandler(quizService, catalogService, sessionRepository, eventBus)
 
 	quiz_desc.RegisterQuizServer(grpcServer, quizHandler)
+	session_desc.RegisterGameSessionServer(grpcServer, sessionHandler)
+	leaderboard_desc.RegisterLeaderboardServer(grpcServer, leaderboardHandler)
+	duel_desc.RegisterArenaServer(grpcServer, arenaHandler)
+	profile_desc.RegisterProfilesServer(grpcServer, profileHandler)
+	achievement_desc.RegisterAchievementsServer(grpcServer, achievementHandler)
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthService.Server())
 
 	reflection.Register(grpcServer)
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
+
+	gatewayHandler := otelhttp.NewHandler(http.StripPrefix("/api", gwMux), "gateway")
 
 	mux := http.NewServeMux()
-	mux.Handle("/api/", gwMux)
+	mux.Handle("/api/", cors.Handler(metrics.InstrumentHandler("gateway", gatewayHandler)))
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/healthz", healthService.LivenessHandler())
+	mux.Handle("/readyz", healthService.ReadinessHandler())
 
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	if config.Frontend.Dir != "" {
+		frontendHandler, err := frontend.New(os.DirFS(config.Frontend.Dir), frontend.Options{
+			CacheMaxAge: config.Frontend.CacheMaxAge.Duration(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to load frontend: %w", err)
+		}
+
+		mux.Handle("/", metrics.InstrumentHandler("frontend", frontendHandler))
+	}
+
+	application := app.New(grpcServer, middleware.RequestID(middleware.AccessLog(mux)), app.Options{
+		GRPCAddr:        config.Server.GRPC.Addr,
+		HTTPAddr:        config.Server.HTTP.Addr,
+		ShutdownTimeout: config.Server.ShutdownTimeout.Duration(),
+	})
+
+	if err = application.Listen(); err != nil {
+		return err
+	}
+
+	// Gateway connections outlive ctx, so requests in flight during graceful
+	// shutdown can still reach the gRPC server.
+	gatewayCtx, gatewayCancel := context.WithCancel(context.Background())
+	defer gatewayCancel()
+
+	if err = registerGateway(gatewayCtx, gwMux, application.GRPCAddr(), gatewayCredentials); err != nil {
+		return err
 	}
 
-	err = quiz_desc.RegisterQuizHandlerFromEndpoint(ctx, gwMux, config.Server.GRPC.Addr, opts)
+	arenaConn, err := grpc.NewClient(application.GRPCAddr(), gatewayDialOptions(gatewayCredentials)...)
 	if err != nil {
-		log.Fatalf("Failed to register quiz handler: %s\n", err)
+		return fmt.Errorf("failed to create arena client: %w", err)
 	}
+	defer arenaConn.Close()
+
+	duelWebSocket := duel_handler.NewWebSocket(duel_desc.NewArenaClient(arenaConn), getDuelWebSocketOptions(config))
+	configWatcher.Subscribe(func(config *app_config.Config) {
+		duelWebSocket.SetOptions(getDuelWebSocketOptions(config))
+	})
+
+	mux.Handle("/api/v1/duels/ws", duelWebSocket)
+
+	application.AddWorker("question pool", questionPool.Run)
+	application.AddWorker("health", healthService.Run)
+	application.AddWorker("config watcher", configWatcher.Run)
+	application.AddWorker("rate limiter", rateLimiter.Run)
+	application.AddWorker("duels", duelService.Run)
+	application.OnShutdown(healthService.Shutdown)
 
-	httpServer := &http.Server{
-		Addr:    config.Server.HTTP.Addr,
-		Handler: mux,
+	return application.Run(ctx)
+}
+
+func gatewayDialOptions(gatewayCredentials *interceptor.GatewayCredentials) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(gatewayCredentials),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	}
+}
 
-	wg := &sync.WaitGroup{}
+func registerGateway(ctx context.Context, gwMux *runtime.ServeMux, grpcAddr string, gatewayCredentials *interceptor.GatewayCredentials) error {
+	opts := gatewayDialOptions(gatewayCredentials)
 
-	wg.Add(1)
-	go func() {
-		log.Printf("Starting gRPC server at %s\n", config.Server.GRPC.Addr)
+	if err := quiz_desc.RegisterQuizHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register quiz handler: %w", err)
+	}
 
-		defer wg.Done()
-		if err = grpcServer.Serve(listener); err != nil {
-			log.Fatalf("Failed to serve gRPC at %s: %s\n", config.Server.GRPC.Addr, err)
-		}
-	}()
+	if err := session_desc.RegisterGameSessionHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register game session handler: %w", err)
+	}
+
+	if err := leaderboard_desc.RegisterLeaderboardHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register leaderboard handler: %w", err)
+	}
+
+	if err := duel_desc.RegisterArenaHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register arena handler: %w", err)
+	}
+
+	if err := daily_desc.RegisterDailyChallengeHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register daily challenge handler: %w", err)
+	}
+
+	if err := profile_desc.RegisterProfilesHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register profiles handler: %w", err)
+	}
+
+	if err := achievement_desc.RegisterAchievementsHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return fmt.Errorf("failed to register achievements handler: %w", err)
+	}
+
+	return nil
+}
 
-	wg.Add(1)
-	go func() {
-		log.Printf("Starting HTTP server at %s\n", config.Server.HTTP.Addr)
+func getSessionHandler(
+	quizService *quiz_service.Quiz,
+	catalogService *catalog_service.Catalog,
+	sessionRepository *session_repository.Memory,
+	eventBus *event.Bus,
+) *session_handler.GameSession {
+	sessionService := session_service.New(quizService, catalogService, sessionRepository, eventBus)
+	return session_handler.NewGameSession(sessionService)
+}
+
+func getCORSOptions(config *app_config.Config) middleware.CORSOptions {
+	return middleware.CORSOptions{
+		AllowedOrigins: config.Server.CORS.AllowedOrigins,
+		AllowedHeaders: config.Server.CORS.AllowedHeaders,
+		ExposedHeaders: config.Server.CORS.ExposedHeaders,
+		MaxAge:         config.Server.CORS.MaxAge.Duration(),
+	}
+}
+
+func getDuelOptions(config *app_config.Config) duel_service.Options {
+	return duel_service.Options{
+		Rounds:       config.Duel.Rounds,
+		RoundTimeout: config.Duel.RoundTimeout.Duration(),
+		BotTimeout:   config.Duel.BotTimeout.Duration(),
+		Bot: duel_service.BotOptions{
+			MinDelay: config.Duel.Bot.MinDelay.Duration(),
+			MaxDelay: config.Duel.Bot.MaxDelay.Duration(),
+			Accuracy: config.Duel.Bot.Accuracy,
+		},
+	}
+}
+
+func getDailyOptions(config *app_config.Config) daily_service.Options {
+	difficulties := make([]quiz_models.Difficulty, 0, len(config.Daily.Difficulties))
+	for _, difficulty := range config.Daily.Difficulties {
+		difficulties = append(difficulties, quiz_models.Difficulty(difficulty))
+	}
+
+	return daily_service.Options{
+		Seed:         config.Daily.Seed,
+		Difficulties: difficulties,
+	}
+}
 
-		defer wg.Done()
-		if err = httpServer.ListenAndServe(); err != nil {
-			log.Fatalf("Failed to serve HTTP at %s: %s\n", config.Server.HTTP.Addr, err)
+func getDuelWebSocketOptions(config *app_config.Config) duel_handler.WebSocketOptions {
+	return duel_handler.WebSocketOptions{
+		AllowedOrigins: config.Server.CORS.AllowedOrigins,
+	}
+}
+
+func getQuizOptions(config *app_config.Config) quiz_service.Options {
+	fuzzyTolerance := make(map[quiz_models.Language]int, len(config.Quiz.Grading.FuzzyTolerance))
+	for language, tolerance := range config.Quiz.Grading.FuzzyTolerance {
+		fuzzyTolerance[quiz_models.Language(language)] = tolerance
+	}
+
+	timeLimits := make(map[quiz_models.Difficulty]time.Duration, len(config.Quiz.Grading.TimeLimits))
+	for difficulty, limit := range config.Quiz.Grading.TimeLimits {
+		timeLimits[quiz_models.Difficulty(difficulty)] = limit.Duration()
+	}
+
+	return quiz_service.Options{
+		FuzzyTolerance: fuzzyTolerance,
+		TimeLimits:     timeLimits,
+		SpeedBonus:     config.Quiz.Grading.SpeedBonus,
+	}
+}
+
+func getRateLimitOptions(config *app_config.Config) ratelimit.Options {
+	toLimits := func(rates map[string]app_config.Rate) map[string]ratelimit.Limit {
+		limits := make(map[string]ratelimit.Limit, len(rates))
+		for method, rate := range rates {
+			limits[method] = ratelimit.Limit{
+				Count:  rate.Count,
+				Period: rate.Period,
+			}
 		}
-	}()
+		return limits
+	}
 
-	<-ctx.Done()
+	return ratelimit.Options{
+		PerUser: toLimits(config.RateLimit.PerUser),
+		PerIP:   toLimits(config.RateLimit.PerIP),
+	}
+}
 
-	slog.Info("Shutting down server...")
+func getQuestionBank(dir string) (*question_bank.Bank, error) {
+	if dir == "" {
+		return question_bank.New(nil), nil
+	}
 
-	_ = httpServer.Shutdown(ctx)
-	grpcServer.GracefulStop()
-	_ = listener.Close()
+	return question_bank.Load(dir)
 }
 
-func getQuizHandler(contentClient *content_client.Client) *quiz_handler.Quiz {
-	quizService := quiz_service.New(contentClient)
-	return quiz_handler.NewQuiz(quizService)
+// getProfileService keeps profiles in Postgres when a DSN is configured. The
+// returned function closes the connection pool.
+func getProfileService(ctx context.Context, config *app_config.Config, healthService *health.Health) (*profile_service.Profile, func(), error) {
+	if config.Postgres.DSN == "" {
+		return profile_service.New(profile_repository.NewMemory()), func() {}, nil
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(config.Postgres.DSN)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse postgres dsn: %w", err)
+	}
+	poolConfig.MaxConns = config.Postgres.MaxConns
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create postgres pool: %w", err)
+	}
+
+	healthService.Register("postgres", health.CheckerFunc(pool.Ping))
+
+	return profile_service.New(profile_repository.NewPostgres(pool)), pool.Close, nil
 }
 
-func getContentServiceClient(ctx context.Context, addr string) *content_client.Client {
-	return content_client.New(ctx, addr)
+func getContentServiceClient(ctx context.Context, config *app_config.Config) *content_client.Client {
+	return content_client.New(ctx, config.ContentService.Addr, getContentServiceOptions(config))
+}
+
+func getContentServiceOptions(config *app_config.Config) content_client.Options {
+	return content_client.Options{
+		Timeout: config.ContentService.Timeout.Duration(),
+		Retry: content_client.RetryOptions{
+			MaxAttempts:    config.ContentService.Retry.MaxAttempts,
+			InitialBackoff: config.ContentService.Retry.InitialBackoff.Duration(),
+			MaxBackoff:     config.ContentService.Retry.MaxBackoff.Duration(),
+		},
+		CircuitBreaker: content_client.CircuitBreakerOptions{
+			FailureThreshold: config.ContentService.CircuitBreaker.FailureThreshold,
+			OpenTimeout:      config.ContentService.CircuitBreaker.OpenTimeout.Duration(),
+		},
+	}
+}
+
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, requestid.Header) {
+		return requestid.Header, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher drops the request ID header, it is already set by the
+// HTTP middleware, and passes Retry-After of rate limited requests as is.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case requestid.Header:
+		return "", false
+	case "retry-after":
+		return "Retry-After", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
 }
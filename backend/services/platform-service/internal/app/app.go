@@ -0,0 +1,195 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+type Options struct {
+	GRPCAddr string
+	HTTPAddr string
+	// ShutdownTimeout limits graceful shutdown of both servers, in-flight
+	// gRPC calls are cancelled once it passes.
+	ShutdownTimeout time.Duration
+}
+
+type worker struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// App runs the gRPC server, the HTTP server and background workers as one
+// group: the first failure stops all others.
+type App struct {
+	options    Options
+	grpcServer *grpc.Server
+	httpServer *http.Server
+
+	workers    []worker
+	onShutdown []func()
+
+	grpcListener net.Listener
+	httpListener net.Listener
+}
+
+func New(grpcServer *grpc.Server, httpHandler http.Handler, options Options) *App {
+	return &App{
+		options:    options,
+		grpcServer: grpcServer,
+		httpServer: &http.Server{
+			Handler:           httpHandler,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// AddWorker registers a background worker. Its context is cancelled on
+// shutdown, returning a non-nil error stops the app.
+func (a *App) AddWorker(name string, run func(ctx context.Context) error) {
+	a.workers = append(a.workers, worker{name: name, run: run})
+}
+
+// OnShutdown registers a hook called before the servers stop accepting
+// requests, e.g. to fail readiness checks.
+func (a *App) OnShutdown(fn func()) {
+	a.onShutdown = append(a.onShutdown, fn)
+}
+
+// Listen binds both servers. It is called by Run if needed, calling it
+// earlier allows resolving ephemeral ports with GRPCAddr and HTTPAddr.
+func (a *App) Listen() error {
+	if a.grpcListener == nil {
+		listener, err := net.Listen("tcp", a.options.GRPCAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen gRPC at %s: %w", a.options.GRPCAddr, err)
+		}
+		a.grpcListener = listener
+	}
+
+	if a.httpListener == nil {
+		listener, err := net.Listen("tcp", a.options.HTTPAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen HTTP at %s: %w", a.options.HTTPAddr, err)
+		}
+		a.httpListener = listener
+	}
+
+	return nil
+}
+
+func (a *App) GRPCAddr() string {
+	return a.grpcListener.Addr().String()
+}
+
+func (a *App) HTTPAddr() string {
+	return a.httpListener.Addr().String()
+}
+
+// Run serves until ctx is done or any component fails, then shuts everything
+// down. It returns the first failure, or nil when stopped by ctx.
+func (a *App) Run(ctx context.Context) error {
+	if err := a.Listen(); err != nil {
+		a.closeListeners()
+		return err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	wg := &sync.WaitGroup{}
+
+	wg.Go(func() {
+		slog.Info("Starting gRPC server", "addr", a.GRPCAddr())
+
+		if err := a.grpcServer.Serve(a.grpcListener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			cancel(fmt.Errorf("failed to serve gRPC: %w", err))
+		}
+	})
+
+	wg.Go(func() {
+		slog.Info("Starting HTTP server", "addr", a.HTTPAddr())
+
+		if err := a.httpServer.Serve(a.httpListener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			cancel(fmt.Errorf("failed to serve HTTP: %w", err))
+		}
+	})
+
+	for _, w := range a.workers {
+		wg.Go(func() {
+			if err := w.run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				cancel(fmt.Errorf("worker %s failed: %w", w.name, err))
+			}
+		})
+	}
+
+	<-ctx.Done()
+
+	err := context.Cause(ctx)
+	if errors.Is(err, context.Canceled) {
+		err = nil
+	}
+
+	if err != nil {
+		slog.Error("Shutting down after failure", "error", err)
+	} else {
+		slog.Info("Shutting down server...")
+	}
+
+	a.shutdown()
+	wg.Wait()
+
+	return err
+}
+
+func (a *App) shutdown() {
+	for _, fn := range a.onShutdown {
+		fn()
+	}
+
+	// ctx is already done here, shutdown gets its own deadline.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.options.ShutdownTimeout)
+	defer cancel()
+
+	wg := &sync.WaitGroup{}
+
+	wg.Go(func() {
+		if err := a.httpServer.Shutdown(shutdownCtx); err != nil {
+			slog.Warn("HTTP server shutdown deadline exceeded, closing connections", "error", err)
+			_ = a.httpServer.Close()
+		}
+	})
+
+	wg.Go(func() {
+		stopped := make(chan struct{})
+		go func() {
+			a.grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-shutdownCtx.Done():
+			slog.Warn("gRPC server shutdown deadline exceeded, cancelling in-flight calls")
+			a.grpcServer.Stop()
+			<-stopped
+		}
+	})
+
+	wg.Wait()
+}
+
+func (a *App) closeListeners() {
+	for _, listener := range []net.Listener{a.grpcListener, a.httpListener} {
+		if listener != nil {
+			_ = listener.Close()
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func newTestApp(t *testing.T, httpHandler http.Handler, shutdownTimeout time.Duration) *App {
+	t.Helper()
+
+	grpcServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+
+	a := New(grpcServer, httpHandler, Options{
+		GRPCAddr:        "127.0.0.1:0",
+		HTTPAddr:        "127.0.0.1:0",
+		ShutdownTimeout: shutdownTimeout,
+	})
+
+	if err := a.Listen(); err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	return a
+}
+
+func runApp(ctx context.Context, a *App) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- a.Run(ctx)
+	}()
+
+	return done
+}
+
+func waitRun(t *testing.T, done <-chan error) error {
+	t.Helper()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatalf("app did not stop")
+		return nil
+	}
+}
+
+func newHealthClient(t *testing.T, addr string) healthpb.HealthClient {
+	t.Helper()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial gRPC: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return healthpb.NewHealthClient(conn)
+}
+
+func TestFailingWorkerStopsServers(t *testing.T) {
+	a := newTestApp(t, http.NotFoundHandler(), time.Second)
+
+	failure := errors.New("queue is gone")
+	started := make(chan struct{})
+	a.AddWorker("consumer", func(_ context.Context) error {
+		<-started
+		return failure
+	})
+
+	done := runApp(context.Background(), a)
+
+	if _, err := newHealthClient(t, a.GRPCAddr()).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("check health before the failure: %v", err)
+	}
+
+	close(started)
+
+	if err := waitRun(t, done); !errors.Is(err, failure) {
+		t.Fatalf("got error %v, want the worker failure", err)
+	}
+
+	for name, addr := range map[string]string{"gRPC": a.GRPCAddr(), "HTTP": a.HTTPAddr()} {
+		if conn, err := net.Dial("tcp", addr); err == nil {
+			_ = conn.Close()
+			t.Errorf("%s server still accepts connections", name)
+		}
+	}
+}
+
+func TestShutdownDeadlineCancelsInFlightCalls(t *testing.T) {
+	httpCancelled := make(chan struct{})
+	httpStarted := make(chan struct{})
+	a := newTestApp(t, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		close(httpStarted)
+		<-r.Context().Done()
+		close(httpCancelled)
+	}), 100*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := runApp(ctx, a)
+
+	// Watch streams until cancelled, it keeps graceful stop waiting.
+	stream, err := newHealthClient(t, a.GRPCAddr()).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("watch health: %v", err)
+	}
+
+	if _, err = stream.Recv(); err != nil {
+		t.Fatalf("receive health status: %v", err)
+	}
+
+	go func() {
+		response, err := http.Get("http://" + a.HTTPAddr())
+		if err == nil {
+			_ = response.Body.Close()
+		}
+	}()
+	<-httpStarted
+
+	start := time.Now()
+	cancel()
+
+	if err = waitRun(t, done); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("shutdown took %v, want about the 100ms deadline", elapsed)
+	}
+
+	if _, err = stream.Recv(); err == nil {
+		t.Errorf("watch stream survived the shutdown deadline")
+	}
+
+	select {
+	case <-httpCancelled:
+	case <-time.After(time.Second):
+		t.Errorf("in-flight HTTP request was not cancelled")
+	}
+}
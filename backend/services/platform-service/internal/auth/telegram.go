@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidInitData = errors.New("invalid init data")
+	ErrExpiredInitData = errors.New("init data expired")
+)
+
+// TelegramValidator checks Telegram Mini App initData as described in
+// https://core.telegram.org/bots/webapps#validating-data-received-via-the-mini-app.
+type TelegramValidator struct {
+	// configured is false for an empty bot token, its secret key is public.
+	configured bool
+	secretKey  []byte
+	maxAge     time.Duration
+	now        func() time.Time
+}
+
+func NewTelegramValidator(botToken string, maxAge time.Duration) *TelegramValidator {
+	return &TelegramValidator{
+		configured: botToken != "",
+		secretKey:  hmacSHA256([]byte("WebAppData"), []byte(botToken)),
+		maxAge:     maxAge,
+		now:        time.Now,
+	}
+}
+
+// Validate returns the user of signed initData. Without a bot token anyone
+// can sign initData, so every initData is rejected.
+func (v *TelegramValidator) Validate(initData string) (*User, error) {
+	if !v.configured {
+		return nil, fmt.Errorf("%w: bot token is not configured", ErrInvalidInitData)
+	}
+
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInitData, err)
+	}
+
+	hash, err := hex.DecodeString(values.Get("hash"))
+	if err != nil || len(hash) == 0 {
+		return nil, fmt.Errorf("%w: missing or malformed hash", ErrInvalidInitData)
+	}
+
+	if !hmac.Equal(hash, v.sign(values)) {
+		return nil, fmt.Errorf("%w: hash mismatch", ErrInvalidInitData)
+	}
+
+	authDate, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed auth_date", ErrInvalidInitData)
+	}
+
+	if v.maxAge > 0 && v.now().Sub(time.Unix(authDate, 0)) > v.maxAge {
+		return nil, ErrExpiredInitData
+	}
+
+	var user User
+	if err = json.Unmarshal([]byte(values.Get("user")), &user); err != nil {
+		return nil, fmt.Errorf("%w: malformed user: %w", ErrInvalidInitData, err)
+	}
+
+	if user.ID == 0 {
+		return nil, fmt.Errorf("%w: missing user id", ErrInvalidInitData)
+	}
+
+	return &user, nil
+}
+
+// Sign returns initData with a valid hash for the given values. It is meant
+// for local development and tests, where the bot token is known.
+func (v *TelegramValidator) Sign(values url.Values) string {
+	values.Del("hash")
+	values.Set("hash", hex.EncodeToString(v.sign(values)))
+
+	return values.Encode()
+}
+
+func (v *TelegramValidator) sign(values url.Values) []byte {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if key != "hash" {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+values.Get(key))
+	}
+
+	return hmacSHA256(v.secretKey, []byte(strings.Join(pairs, "\n")))
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const testBotToken = "123456:test-token"
+
+var testNow = time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+
+func newTestValidator() *TelegramValidator {
+	v := NewTelegramValidator(testBotToken, time.Hour)
+	v.now = func() time.Time {
+		return testNow
+	}
+
+	return v
+}
+
+func initValues(authDate time.Time) url.Values {
+	return url.Values{
+		"auth_date": {strconv.FormatInt(authDate.Unix(), 10)},
+		"query_id":  {"AAHdF6IQAAAAAN0XohDhrOrc"},
+		"user":      {`{"id":42,"first_name":"Ada","username":"ada"}`},
+	}
+}
+
+func TestValidateAcceptsValidPayload(t *testing.T) {
+	values := initValues(testNow.Add(-time.Minute))
+
+	// The hash is computed as the Telegram docs describe, independently of
+	// Sign.
+	secretKey := hmac.New(sha256.New, []byte("WebAppData"))
+	secretKey.Write([]byte(testBotToken))
+	mac := hmac.New(sha256.New, secretKey.Sum(nil))
+	mac.Write([]byte("auth_date=" + values.Get("auth_date") +
+		"\nquery_id=" + values.Get("query_id") +
+		"\nuser=" + values.Get("user")))
+	values.Set("hash", hex.EncodeToString(mac.Sum(nil)))
+
+	user, err := newTestValidator().Validate(values.Encode())
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+
+	if user.ID != 42 || user.FirstName != "Ada" || user.Username != "ada" {
+		t.Errorf("got user %+v, want Ada with id 42", user)
+	}
+}
+
+func TestSignMatchesValidate(t *testing.T) {
+	v := newTestValidator()
+
+	if _, err := v.Validate(v.Sign(initValues(testNow))); err != nil {
+		t.Errorf("validate signed init data: %v", err)
+	}
+}
+
+func TestValidateRejectsForgedHash(t *testing.T) {
+	v := newTestValidator()
+
+	forger := NewTelegramValidator("654321:other-token", time.Hour)
+	if _, err := v.Validate(forger.Sign(initValues(testNow))); !errors.Is(err, ErrInvalidInitData) {
+		t.Errorf("got error %v for a hash signed with another token, want ErrInvalidInitData", err)
+	}
+
+	values, err := url.ParseQuery(v.Sign(initValues(testNow)))
+	if err != nil {
+		t.Fatalf("parse signed init data: %v", err)
+	}
+	values.Set("user", `{"id":1,"first_name":"Mallory"}`)
+
+	if _, err = v.Validate(values.Encode()); !errors.Is(err, ErrInvalidInitData) {
+		t.Errorf("got error %v for a tampered user, want ErrInvalidInitData", err)
+	}
+
+	values.Del("hash")
+	if _, err = v.Validate(values.Encode()); !errors.Is(err, ErrInvalidInitData) {
+		t.Errorf("got error %v without a hash, want ErrInvalidInitData", err)
+	}
+}
+
+func TestValidateRejectsStaleAuthDate(t *testing.T) {
+	v := newTestValidator()
+
+	_, err := v.Validate(v.Sign(initValues(testNow.Add(-2 * time.Hour))))
+	if !errors.Is(err, ErrExpiredInitData) {
+		t.Errorf("got error %v, want ErrExpiredInitData", err)
+	}
+}
+
+func TestValidateRejectsMissingUser(t *testing.T) {
+	v := newTestValidator()
+
+	values := initValues(testNow)
+	values.Del("user")
+
+	if _, err := v.Validate(v.Sign(values)); !errors.Is(err, ErrInvalidInitData) {
+		t.Errorf("got error %v, want ErrInvalidInitData", err)
+	}
+}
+
+func TestValidateRejectsEmptyBotToken(t *testing.T) {
+	// With an empty token the secret key is HMAC("WebAppData", ""), anyone
+	// can sign initData with it.
+	v := NewTelegramValidator("", time.Hour)
+	v.now = func() time.Time {
+		return testNow
+	}
+
+	if _, err := v.Validate(v.Sign(initValues(testNow))); !errors.Is(err, ErrInvalidInitData) {
+		t.Errorf("got error %v, want ErrInvalidInitData", err)
+	}
+}
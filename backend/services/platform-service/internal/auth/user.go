@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+type User struct {
+	ID           int64  `json:"id"`
+	Username     string `json:"username"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	LanguageCode string `json:"language_code"`
+}
+
+func (u *User) TelegramID() string {
+	return strconv.FormatInt(u.ID, 10)
+}
+
+func (u *User) DisplayName() string {
+	if u.Username != "" {
+		return "@" + u.Username
+	}
+
+	return strings.TrimSpace(u.FirstName + " " + u.LastName)
+}
+
+type userContextKey struct{}
+
+func WithUser(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+func UserFromContext(ctx context.Context) (*User, error) {
+	user, ok := ctx.Value(userContextKey{}).(*User)
+	if !ok || user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	return user, nil
+}
@@ -0,0 +1,100 @@
+package content_service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+var ErrCircuitOpen = fmt.Errorf("%w: circuit breaker is open", ErrUnavailable)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures that opens the
+	// breaker. Zero disables the breaker.
+	FailureThreshold int
+	OpenTimeout      time.Duration
+}
+
+type circuitBreaker struct {
+	mu       sync.Mutex
+	options  CircuitBreakerOptions
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+	now      func() time.Time
+}
+
+func newCircuitBreaker(options CircuitBreakerOptions) *circuitBreaker {
+	return &circuitBreaker{
+		options: options,
+		now:     time.Now,
+	}
+}
+
+// SetOptions applies new settings keeping the current state, so an open
+// breaker stays open until the new timeout passes.
+func (b *circuitBreaker) SetOptions(options CircuitBreakerOptions) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.options = options
+}
+
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.options.FailureThreshold <= 0 {
+		return true
+	}
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.options.OpenTimeout {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *circuitBreaker) Record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.options.FailureThreshold <= 0 {
+		return
+	}
+
+	b.probing = false
+
+	if !failed {
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.options.FailureThreshold {
+		b.state = breakerOpen
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,68 @@
+package content_service
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestBreaker(options CircuitBreakerOptions) (*circuitBreaker, *time.Time) {
+	now := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+
+	breaker := newCircuitBreaker(options)
+	breaker.now = func() time.Time {
+		return now
+	}
+
+	return breaker, &now
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	breaker, now := newTestBreaker(CircuitBreakerOptions{FailureThreshold: 1, OpenTimeout: time.Minute})
+
+	breaker.Record(true)
+	if breaker.Allow() {
+		t.Fatalf("open breaker allowed a request")
+	}
+
+	*now = now.Add(time.Minute)
+
+	if !breaker.Allow() {
+		t.Fatalf("breaker did not allow a probe after the open timeout")
+	}
+
+	if breaker.Allow() {
+		t.Fatalf("breaker allowed a second request while probing")
+	}
+
+	breaker.Record(true)
+	if breaker.Allow() {
+		t.Fatalf("failed probe did not reopen the breaker")
+	}
+
+	*now = now.Add(time.Minute)
+
+	if !breaker.Allow() {
+		t.Fatalf("breaker did not allow a probe after the open timeout")
+	}
+
+	breaker.Record(false)
+	if !breaker.Allow() || !breaker.Allow() {
+		t.Errorf("successful probe did not close the breaker")
+	}
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	breaker, _ := newTestBreaker(CircuitBreakerOptions{})
+
+	for range 10 {
+		breaker.Record(true)
+	}
+
+	// Enabling keeps the state, failures recorded while disabled must not
+	// open the breaker.
+	breaker.SetOptions(CircuitBreakerOptions{FailureThreshold: 3, OpenTimeout: time.Minute})
+
+	if !breaker.Allow() {
+		t.Errorf("failures recorded while disabled opened the breaker")
+	}
+}
@@ -6,21 +6,55 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"sync/atomic"
+	"time"
 
+	"github.com/casnerano/snippet-war/internal/metrics"
 	models "github.com/casnerano/snippet-war/internal/model/quiz"
+	"github.com/casnerano/snippet-war/internal/requestid"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/casnerano/snippet-war/internal/client/content_service")
+
+type Options struct {
+	// Timeout limits every single attempt, retries get their own timeout.
+	Timeout        time.Duration
+	Retry          RetryOptions
+	CircuitBreaker CircuitBreakerOptions
+}
+
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
+	options    atomic.Pointer[Options]
+	breaker    *circuitBreaker
 }
 
-func New(_ context.Context, host string) *Client {
-	return &Client{
-		httpClient: &http.Client{},
-		baseURL:    host,
+func New(_ context.Context, host string, options Options) *Client {
+	client := &Client{
+		httpClient: &http.Client{
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+		baseURL: host,
+		breaker: newCircuitBreaker(options.CircuitBreaker),
 	}
+	client.options.Store(&options)
+
+	return client
+}
+
+// SetOptions replaces timeouts, retry and circuit breaker settings. Requests
+// in flight keep the settings they started with.
+func (s *Client) SetOptions(options Options) {
+	s.options.Store(&options)
+	s.breaker.SetOptions(options.CircuitBreaker)
 }
 
 type GetQuestionsArgs struct {
@@ -28,22 +62,75 @@ type GetQuestionsArgs struct {
 	Topics     []string
 	Difficulty models.Difficulty
 	Limit      uint32
+	// AnswerType defaults to multiple choice, AnswerTypeMixed requests both
+	// types in roughly equal parts.
+	AnswerType models.AnswerType
+}
+
+// GetQuestions requests new questions for the user. Unavailable and rate
+// limited requests are retried, timeouts are not: the batch is a POST and may
+// have been processed already, so a timed out request is attempted once.
+func (s *Client) GetQuestions(ctx context.Context, tgUserID string, args GetQuestionsArgs) (_ []*models.Question, err error) {
+	ctx, span := tracer.Start(ctx, "content_service.GetQuestions", trace.WithAttributes(
+		attribute.String("quiz.language", args.Language.String()),
+		attribute.String("quiz.difficulty", args.Difficulty.String()),
+		attribute.String("quiz.answer_type", args.AnswerType.String()),
+		attribute.Int("quiz.limit", int(args.Limit)),
+	))
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	if args.AnswerType != models.AnswerTypeMixed {
+		return s.getQuestions(ctx, tgUserID, args, answerTypeOrDefault(args.AnswerType))
+	}
+
+	freeTextCount := args.Limit / 2
+	if args.Limit%2 == 1 && rand.IntN(2) == 0 {
+		freeTextCount++
+	}
+
+	var questions []*models.Question
+	for answerType, count := range map[models.AnswerType]uint32{
+		models.AnswerTypeMultipleChoice: args.Limit - freeTextCount,
+		models.AnswerTypeFreeText:       freeTextCount,
+	} {
+		if count == 0 {
+			continue
+		}
+
+		typedArgs := args
+		typedArgs.Limit = count
+
+		typed, err := s.getQuestions(ctx, tgUserID, typedArgs, answerType)
+		if err != nil {
+			return nil, err
+		}
+
+		questions = append(questions, typed...)
+	}
+
+	rand.Shuffle(len(questions), func(i, j int) {
+		questions[i], questions[j] = questions[j], questions[i]
+	})
+
+	return questions, nil
 }
 
-func (s *Client) GetQuestions(ctx context.Context, tgUserID string, args GetQuestionsArgs) ([]*models.Question, error) {
+func (s *Client) getQuestions(ctx context.Context, tgUserID string, args GetQuestionsArgs, answerType models.AnswerType) ([]*models.Question, error) {
 	payload := struct {
 		Language     models.Language   `json:"language"`
 		Topics       []string          `json:"topics"`
 		Difficulty   models.Difficulty `json:"difficulty"`
 		Count        uint32            `json:"count"`
 		QuestionType models.AnswerType `json:"question_type"`
-		TgUserID     string            `json:"telegram_user_id"`
+		TgUserID     string            `json:"telegram_user_id,omitempty"`
 	}{
 		Language:     args.Language,
 		Topics:       args.Topics,
 		Difficulty:   args.Difficulty,
 		Count:        args.Limit,
-		QuestionType: models.AnswerTypeMultipleChoice,
+		QuestionType: answerType,
 		TgUserID:     tgUserID,
 	}
 
@@ -52,21 +139,92 @@ func (s *Client) GetQuestions(ctx context.Context, tgUserID string, args GetQues
 		return nil, fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
-	request, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodPost,
-		s.baseURL+"/api/questions/batch",
-		bytes.NewReader(bPayload),
-	)
+	var questions Questions
+	if err = s.do(ctx, http.MethodPost, "/api/questions/batch", bPayload, &questions); err != nil {
+		return nil, err
+	}
+
+	return questions.ToModels(), nil
+}
+
+// Health checks the content service health endpoint. It bypasses retries and
+// the circuit breaker, so it reflects the current state of the service.
+func (s *Client) Health(ctx context.Context) error {
+	var response struct {
+		Status string `json:"status"`
+	}
+
+	if err := s.doOnce(ctx, s.options.Load().Timeout, http.MethodGet, "/health", nil, &response); err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("%w: health status %q", ErrUnavailable, response.Status)
+	}
+
+	return nil
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func answerTypeOrDefault(answerType models.AnswerType) models.AnswerType {
+	if answerType == models.AnswerTypeUnspecified {
+		return models.AnswerTypeMultipleChoice
+	}
+
+	return answerType
+}
+
+func (s *Client) do(ctx context.Context, method, path string, payload []byte, out any) error {
+	options := s.options.Load()
+
+	// Only reads are idempotent, a timed out POST may have been processed.
+	idempotent := method == http.MethodGet || method == http.MethodHead
+
+	return retry(ctx, options.Retry, idempotent, func(ctx context.Context) error {
+		start := time.Now()
+
+		if !s.breaker.Allow() {
+			metrics.ObserveContentServiceRequest(path, outcome(ErrCircuitOpen), time.Since(start))
+			return ErrCircuitOpen
+		}
+
+		err := s.doOnce(ctx, options.Timeout, method, path, payload, out)
+		s.breaker.Record(isBreakerFailure(err))
+		metrics.ObserveContentServiceRequest(path, outcome(err), time.Since(start))
+
+		return err
+	})
+}
+
+func (s *Client) doOnce(ctx context.Context, timeout time.Duration, method, path string, payload []byte, out any) error {
+	callerCtx := ctx
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, bytes.NewReader(payload))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	request.Header.Set("Content-Type", "application/json")
+	if id := requestid.FromContext(ctx); id != "" {
+		request.Header.Set(requestid.Header, id)
+	}
 
 	response, err := s.httpClient.Do(request)
 	if err != nil {
-		return nil, fmt.Errorf("failed request: %w", err)
+		return fmt.Errorf("failed request: %w", transportError(callerCtx, err))
 	}
 	defer func() {
 		_ = response.Body.Close()
@@ -74,28 +232,27 @@ func (s *Client) GetQuestions(ctx context.Context, tgUserID string, args GetQues
 
 	if response.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(response.Body)
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", response.StatusCode, string(body))
+		return newResponseError(response.StatusCode, response.Header, body)
 	}
 
-	var questions Questions
-	if err = json.NewDecoder(response.Body).Decode(&questions); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err = json.NewDecoder(response.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return questions.ToModels(), nil
+	return nil
 }
 
 type Question struct {
-	ID          string            `json:"id"`
-	Language    models.Language   `json:"language"`
-	Topic       string            `json:"topic"`
-	Difficulty  models.Difficulty `json:"difficulty"`
-	Code        string            `json:"code"`
-	Question    string            `json:"question"`
-	Options     []string          `json:"options,omitempty"`
-	Answers     []string          `json:"correct_answers"`
-	Explanation string            `json:"explanation"`
-	Type        models.AnswerType `json:"question_type"`
+	ID          string            `json:"id" yaml:"id"`
+	Language    models.Language   `json:"language" yaml:"language"`
+	Topic       string            `json:"topic" yaml:"topic"`
+	Difficulty  models.Difficulty `json:"difficulty" yaml:"difficulty"`
+	Code        string            `json:"code" yaml:"code"`
+	Question    string            `json:"question" yaml:"question"`
+	Options     []string          `json:"options,omitempty" yaml:"options,omitempty"`
+	Answers     []string          `json:"correct_answers" yaml:"correct_answers"`
+	Explanation string            `json:"explanation" yaml:"explanation"`
+	Type        models.AnswerType `json:"question_type" yaml:"question_type"`
 }
 
 type Questions []*Question
@@ -0,0 +1,247 @@
+package content_service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+const questionsResponse = `[{"id":"q1","language":"go","topic":"maps","difficulty":"beginner","question":"?","options":["1","2"],"correct_answers":["2"],"question_type":"multiple_choice"}]`
+
+// contentService stands in for the content service, handle answers every
+// request and counts them.
+type contentService struct {
+	requests atomic.Int32
+	handle   func(w http.ResponseWriter, r *http.Request, request int32)
+}
+
+func newContentService(t *testing.T, handle func(w http.ResponseWriter, r *http.Request, request int32)) (*contentService, *httptest.Server) {
+	t.Helper()
+
+	service := &contentService{handle: handle}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		service.handle(w, r, service.requests.Add(1))
+	}))
+	t.Cleanup(server.Close)
+
+	return service, server
+}
+
+func testOptions() Options {
+	return Options{
+		Timeout: time.Second,
+		Retry: RetryOptions{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Second,
+		},
+	}
+}
+
+func getQuestions(ctx context.Context, client *Client) ([]*models.Question, error) {
+	return client.GetQuestions(ctx, "", GetQuestionsArgs{
+		Language:   models.LanguageGo,
+		Difficulty: models.DifficultyBeginner,
+		Limit:      1,
+	})
+}
+
+func TestGetQuestionsRetriesUnavailable(t *testing.T) {
+	service, server := newContentService(t, func(w http.ResponseWriter, _ *http.Request, request int32) {
+		if request < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		_, _ = w.Write([]byte(questionsResponse))
+	})
+
+	questions, err := getQuestions(context.Background(), New(context.Background(), server.URL, testOptions()))
+	if err != nil {
+		t.Fatalf("get questions: %v", err)
+	}
+
+	if len(questions) != 1 || questions[0].ID != "q1" {
+		t.Errorf("got %d questions, want q1", len(questions))
+	}
+
+	if got := service.requests.Load(); got != 3 {
+		t.Errorf("got %d requests, want 3", got)
+	}
+}
+
+func TestGetQuestionsDoesNotRetryValidation(t *testing.T) {
+	service, server := newContentService(t, func(w http.ResponseWriter, _ *http.Request, _ int32) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"detail":[{"loc":["body","count"],"msg":"too large"}]}`))
+	})
+
+	_, err := getQuestions(context.Background(), New(context.Background(), server.URL, testOptions()))
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("got error %v, want ErrValidation", err)
+	}
+
+	var responseErr *ResponseError
+	if !errors.As(err, &responseErr) || responseErr.Detail != "body.count: too large" {
+		t.Errorf("got error %v, want the violation detail", err)
+	}
+
+	if got := service.requests.Load(); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestGetQuestionsAttemptsTimedOutPostOnce(t *testing.T) {
+	hang := make(chan struct{})
+	var method atomic.Value
+	service, server := newContentService(t, func(_ http.ResponseWriter, r *http.Request, _ int32) {
+		method.Store(r.Method)
+		<-hang
+	})
+	t.Cleanup(func() {
+		close(hang)
+	})
+
+	// Attempts left and no backoff, only the method prevents a retry.
+	options := testOptions()
+	options.Timeout = 50 * time.Millisecond
+	options.Retry.InitialBackoff = 0
+	options.Retry.MaxBackoff = 0
+
+	_, err := getQuestions(context.Background(), New(context.Background(), server.URL, options))
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("got error %v, want ErrTimeout", err)
+	}
+
+	// The batch is a POST, it may have been processed before the timeout.
+	if got := method.Load(); got != http.MethodPost {
+		t.Errorf("got %v request, want POST", got)
+	}
+	if got := service.requests.Load(); got != 1 {
+		t.Errorf("got %d attempts, want 1 of %d allowed", got, options.Retry.MaxAttempts)
+	}
+}
+
+func TestGetQuestionsHonorsRetryAfter(t *testing.T) {
+	service, server := newContentService(t, func(w http.ResponseWriter, _ *http.Request, request int32) {
+		if request == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		_, _ = w.Write([]byte(questionsResponse))
+	})
+
+	start := time.Now()
+	if _, err := getQuestions(context.Background(), New(context.Background(), server.URL, testOptions())); err != nil {
+		t.Fatalf("get questions: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least the requested second", elapsed)
+	}
+
+	if got := service.requests.Load(); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+}
+
+func TestGetQuestionsGivesUpOnLongRetryAfter(t *testing.T) {
+	service, server := newContentService(t, func(w http.ResponseWriter, _ *http.Request, _ int32) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := getQuestions(context.Background(), New(context.Background(), server.URL, testOptions()))
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("got error %v, want ErrRateLimited", err)
+	}
+
+	if got := service.requests.Load(); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestCircuitBreakerOpensAfterFailures(t *testing.T) {
+	service, server := newContentService(t, func(w http.ResponseWriter, _ *http.Request, _ int32) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	options := testOptions()
+	options.Retry.MaxAttempts = 1
+	options.CircuitBreaker = CircuitBreakerOptions{FailureThreshold: 2, OpenTimeout: time.Minute}
+	client := New(context.Background(), server.URL, options)
+
+	for range 2 {
+		if _, err := getQuestions(context.Background(), client); !errors.Is(err, ErrUnexpected) {
+			t.Fatalf("got error %v, want ErrUnexpected", err)
+		}
+	}
+
+	_, err := getQuestions(context.Background(), client)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("got error %v, want ErrCircuitOpen", err)
+	}
+
+	if got := service.requests.Load(); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+}
+
+func TestCircuitBreakerIgnoresCallerDeadline(t *testing.T) {
+	hang := make(chan struct{})
+	service, server := newContentService(t, func(w http.ResponseWriter, _ *http.Request, request int32) {
+		if request == 1 {
+			<-hang
+			return
+		}
+
+		_, _ = w.Write([]byte(questionsResponse))
+	})
+	t.Cleanup(func() {
+		close(hang)
+	})
+
+	options := testOptions()
+	options.CircuitBreaker = CircuitBreakerOptions{FailureThreshold: 1, OpenTimeout: time.Minute}
+	client := New(context.Background(), server.URL, options)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := getQuestions(ctx, client)
+	if !errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrTimeout) {
+		t.Fatalf("got error %v, want the caller deadline", err)
+	}
+
+	if _, err = getQuestions(context.Background(), client); err != nil {
+		t.Fatalf("get questions after the caller deadline: %v", err)
+	}
+
+	if got := service.requests.Load(); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	_, server := newContentService(t, func(w http.ResponseWriter, r *http.Request, _ int32) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"status":"degraded"}`))
+	})
+
+	err := New(context.Background(), server.URL, testOptions()).Health(context.Background())
+	if !errors.Is(err, ErrUnavailable) {
+		t.Errorf("got error %v, want ErrUnavailable", err)
+	}
+}
@@ -0,0 +1,166 @@
+package content_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrValidation  = errors.New("content service rejected request")
+	ErrNotFound    = errors.New("content service resource not found")
+	ErrUnavailable = errors.New("content service unavailable")
+	ErrTimeout     = errors.New("content service timeout")
+	ErrRateLimited = errors.New("content service rate limited")
+	ErrUnexpected  = errors.New("content service unexpected response")
+)
+
+type ResponseError struct {
+	StatusCode int
+	Detail     string
+	// RetryAfter is the wait requested by the Retry-After header, zero when
+	// the header is missing.
+	RetryAfter time.Duration
+
+	kind error
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%s: status code %d: %s", e.kind, e.StatusCode, e.Detail)
+}
+
+func (e *ResponseError) Unwrap() error {
+	return e.kind
+}
+
+func newResponseError(statusCode int, header http.Header, body []byte) *ResponseError {
+	return &ResponseError{
+		StatusCode: statusCode,
+		Detail:     parseDetail(body),
+		RetryAfter: parseRetryAfter(header.Get("Retry-After"), time.Now()),
+		kind:       errorKind(statusCode),
+	}
+}
+
+// parseRetryAfter accepts both delay seconds and an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+
+	return 0
+}
+
+func errorKind(statusCode int) error {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return ErrTimeout
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return ErrUnavailable
+	default:
+		return ErrUnexpected
+	}
+}
+
+// parseDetail extracts a human-readable message from FastAPI error payloads:
+// {"detail": "..."}, {"detail": [{"loc": [...], "msg": "..."}]} or {"error": "..."}.
+func parseDetail(body []byte) string {
+	var payload struct {
+		Detail json.RawMessage `json:"detail"`
+		Error  string          `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return strings.TrimSpace(string(body))
+	}
+
+	if payload.Error != "" {
+		return payload.Error
+	}
+
+	var detail string
+	if err := json.Unmarshal(payload.Detail, &detail); err == nil {
+		return detail
+	}
+
+	var violations []struct {
+		Loc []any  `json:"loc"`
+		Msg string `json:"msg"`
+	}
+	if err := json.Unmarshal(payload.Detail, &violations); err == nil && len(violations) > 0 {
+		messages := make([]string, 0, len(violations))
+		for _, violation := range violations {
+			loc := make([]string, 0, len(violation.Loc))
+			for _, part := range violation.Loc {
+				loc = append(loc, fmt.Sprint(part))
+			}
+			messages = append(messages, fmt.Sprintf("%s: %s", strings.Join(loc, "."), violation.Msg))
+		}
+		return strings.Join(messages, "; ")
+	}
+
+	return strings.TrimSpace(string(body))
+}
+
+// transportError classifies a failed attempt. Cancellation and the deadline
+// of the caller are returned as is, they say nothing about the service.
+func transportError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return fmt.Errorf("%w: %w", ErrTimeout, err)
+	}
+
+	return fmt.Errorf("%w: %w", ErrUnavailable, err)
+}
+
+// outcome classifies the result of a request attempt for metrics.
+func outcome(err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case errors.Is(err, ErrCircuitOpen):
+		return "circuit_open"
+	case errors.Is(err, ErrValidation):
+		return "validation"
+	case errors.Is(err, ErrNotFound):
+		return "not_found"
+	case errors.Is(err, ErrRateLimited):
+		return "rate_limited"
+	case errors.Is(err, ErrTimeout):
+		return "timeout"
+	case errors.Is(err, ErrUnavailable):
+		return "unavailable"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "canceled"
+	default:
+		return "error"
+	}
+}
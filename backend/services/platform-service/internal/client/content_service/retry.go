@@ -0,0 +1,100 @@
+package content_service
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+type RetryOptions struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// retry calls fn until it succeeds or fails for good. Timeouts of
+// non-idempotent requests are not retried, the request may have been
+// processed.
+func retry(ctx context.Context, options RetryOptions, idempotent bool, fn func(ctx context.Context) error) error {
+	attempts := max(options.MaxAttempts, 1)
+
+	var err error
+	for attempt := range attempts {
+		if err = fn(ctx); err == nil || !isRetryable(err, idempotent) || attempt == attempts-1 {
+			return err
+		}
+
+		delay, ok := retryDelay(options, attempt, err)
+		if !ok {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+
+	return err
+}
+
+// retryDelay honors Retry-After of the response, a server asking to wait
+// longer than MaxBackoff is not retried.
+func retryDelay(options RetryOptions, attempt int, err error) (time.Duration, bool) {
+	delay := backoff(options, attempt)
+
+	var responseErr *ResponseError
+	if errors.As(err, &responseErr) && responseErr.RetryAfter > 0 {
+		if responseErr.RetryAfter > options.MaxBackoff {
+			return 0, false
+		}
+
+		delay = max(delay, responseErr.RetryAfter)
+	}
+
+	return delay, true
+}
+
+// backoff returns exponential backoff with full jitter for the given attempt.
+func backoff(options RetryOptions, attempt int) time.Duration {
+	delay := options.InitialBackoff << attempt
+	if delay <= 0 || delay > options.MaxBackoff {
+		delay = options.MaxBackoff
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	return rand.N(delay)
+}
+
+// isRetryable reports whether err is transient. Timeouts are retried for
+// idempotent requests only, e.g. never for the questions batch POST.
+func isRetryable(err error, idempotent bool) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+
+	return errors.Is(err, ErrUnavailable) ||
+		(idempotent && errors.Is(err, ErrTimeout)) ||
+		errors.Is(err, ErrRateLimited)
+}
+
+func isBreakerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var responseErr *ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.StatusCode >= 500
+	}
+
+	return errors.Is(err, ErrUnavailable) || errors.Is(err, ErrTimeout)
+}
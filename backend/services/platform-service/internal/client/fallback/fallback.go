@@ -0,0 +1,64 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/casnerano/snippet-war/internal/client/content_service"
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type contentProvider interface {
+	GetQuestions(ctx context.Context, tgUserID string, args content_service.GetQuestionsArgs) ([]*models.Question, error)
+}
+
+// Provider asks the primary provider first and switches to the fallback one
+// when the primary fails for reasons other than a bad request.
+type Provider struct {
+	primary  contentProvider
+	fallback contentProvider
+}
+
+func New(primary, fallback contentProvider) *Provider {
+	return &Provider{
+		primary:  primary,
+		fallback: fallback,
+	}
+}
+
+func (p *Provider) GetQuestions(ctx context.Context, tgUserID string, args content_service.GetQuestionsArgs) ([]*models.Question, error) {
+	questions, err := p.primary.GetQuestions(ctx, tgUserID, args)
+	if err == nil || p.fallback == nil || !shouldFallback(err) {
+		return questions, err
+	}
+
+	slog.WarnContext(ctx, "failed get questions from primary provider, using fallback", "reason", reason(err), "error", err)
+
+	fallbackQuestions, fallbackErr := p.fallback.GetQuestions(ctx, tgUserID, args)
+	if fallbackErr != nil || len(fallbackQuestions) == 0 {
+		return nil, errors.Join(err, fallbackErr)
+	}
+
+	return fallbackQuestions, nil
+}
+
+func shouldFallback(err error) bool {
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, content_service.ErrValidation)
+}
+
+// reason names the failure of the primary provider in logs.
+func reason(err error) string {
+	switch {
+	case errors.Is(err, content_service.ErrCircuitOpen):
+		return "circuit_open"
+	case errors.Is(err, content_service.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, content_service.ErrRateLimited):
+		return "rate_limited"
+	case errors.Is(err, content_service.ErrUnavailable):
+		return "unavailable"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,153 @@
+package question_bank
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/casnerano/snippet-war/internal/client/content_service"
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+	"go.yaml.in/yaml/v3"
+)
+
+// Bank serves questions from local JSON/YAML files. Every file holds a list of
+// questions in the content service format.
+type Bank struct {
+	questions []*models.Question
+}
+
+func New(questions []*models.Question) *Bank {
+	return &Bank{
+		questions: questions,
+	}
+}
+
+func Load(dir string) (*Bank, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed read question bank %q: %w", dir, err)
+	}
+
+	var questions []*models.Question
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		fileName := filepath.Join(dir, entry.Name())
+
+		fileQuestions, err := readFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		questions = append(questions, fileQuestions...)
+	}
+
+	return New(questions), nil
+}
+
+func readFile(fileName string) ([]*models.Question, error) {
+	var unmarshal func(data []byte, v any) error
+
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".json":
+		unmarshal = json.Unmarshal
+	case ".yaml", ".yml":
+		unmarshal = yaml.Unmarshal
+	default:
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed read question bank file %q: %w", fileName, err)
+	}
+
+	var questions content_service.Questions
+	if err = unmarshal(data, &questions); err != nil {
+		return nil, fmt.Errorf("failed parse question bank file %q: %w", fileName, err)
+	}
+
+	for idx, question := range questions {
+		if question.ID == "" {
+			question.ID = fmt.Sprintf("bank:%s:%d", filepath.Base(fileName), idx)
+		}
+
+		if question.Type != models.AnswerTypeMultipleChoice && question.Type != models.AnswerTypeFreeText {
+			return nil, fmt.Errorf("invalid question %q in %q: unknown question type %q", question.ID, fileName, question.Type)
+		}
+
+		if len(question.Answers) == 0 {
+			return nil, fmt.Errorf("invalid question %q in %q: no correct answers", question.ID, fileName)
+		}
+	}
+
+	return questions.ToModels(), nil
+}
+
+func (b *Bank) GetQuestions(_ context.Context, _ string, args content_service.GetQuestionsArgs) ([]*models.Question, error) {
+	var matched []*models.Question
+	for _, question := range b.questions {
+		if question.Language == args.Language &&
+			question.Difficulty == args.Difficulty &&
+			slices.Contains(args.Topics, question.Topic) &&
+			matchAnswerType(args.AnswerType, question.Answer.AnswerType()) {
+			matched = append(matched, question)
+		}
+	}
+
+	rand.Shuffle(len(matched), func(i, j int) {
+		matched[i], matched[j] = matched[j], matched[i]
+	})
+
+	if uint32(len(matched)) > args.Limit {
+		matched = matched[:args.Limit]
+	}
+
+	return matched, nil
+}
+
+// Check reports whether the bank can serve questions at all, it is used as
+// the readiness fallback of the content service.
+func (b *Bank) Check(_ context.Context) error {
+	if len(b.questions) == 0 {
+		return errors.New("question bank is empty")
+	}
+
+	return nil
+}
+
+// ListQuestions returns every question of the language ordered by ID, the
+// order does not depend on the order of files or of questions in them.
+func (b *Bank) ListQuestions(_ context.Context, language models.Language) ([]*models.Question, error) {
+	var questions []*models.Question
+	for _, question := range b.questions {
+		if question.Language == language {
+			questions = append(questions, question)
+		}
+	}
+
+	slices.SortFunc(questions, func(a, b *models.Question) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return questions, nil
+}
+
+func matchAnswerType(requested, actual models.AnswerType) bool {
+	switch requested {
+	case models.AnswerTypeMixed:
+		return true
+	case models.AnswerTypeUnspecified:
+		return actual == models.AnswerTypeMultipleChoice
+	default:
+		return requested == actual
+	}
+}
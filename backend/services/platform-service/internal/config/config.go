@@ -1,14 +1,25 @@
 package config
 
 import (
+	"bytes"
 	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+
+	"go.yaml.in/yaml/v3"
 )
 
-const defaultConfigName = "default.json"
+const (
+	defaultConfigName = "default.json"
+	redacted          = "[REDACTED]"
+)
 
 //go:embed *.json
 var defaultConfig embed.FS
@@ -21,13 +32,136 @@ type Config struct {
 		HTTP struct {
 			Addr string `json:"addr"`
 		} `json:"http"`
+		ShutdownTimeout Duration `json:"shutdown_timeout"`
+		CORS            struct {
+			// AllowedOrigins lists origins allowed to call /api/, "*" allows
+			// any. Empty allows same origin requests only.
+			AllowedOrigins []string `json:"allowed_origins"`
+			AllowedHeaders []string `json:"allowed_headers"`
+			ExposedHeaders []string `json:"exposed_headers"`
+			MaxAge         Duration `json:"max_age"`
+		} `json:"cors"`
 	} `json:"server"`
+	Frontend struct {
+		// Dir is the static frontend directory served at /, empty disables
+		// serving the frontend.
+		Dir         string   `json:"dir"`
+		CacheMaxAge Duration `json:"cache_max_age"`
+	} `json:"frontend"`
 	ContentService struct {
-		Addr string `json:"addr"`
+		Addr    string   `json:"addr"`
+		Timeout Duration `json:"timeout"`
+		Retry   struct {
+			MaxAttempts    int      `json:"max_attempts"`
+			InitialBackoff Duration `json:"initial_backoff"`
+			MaxBackoff     Duration `json:"max_backoff"`
+		} `json:"retry"`
+		CircuitBreaker struct {
+			FailureThreshold int      `json:"failure_threshold"`
+			OpenTimeout      Duration `json:"open_timeout"`
+		} `json:"circuit_breaker"`
 	} `json:"content_service"`
+	Postgres struct {
+		// DSN of the database shared with the content service, empty keeps
+		// player profiles in memory.
+		DSN      string `json:"dsn"`
+		MaxConns int32  `json:"max_conns"`
+	} `json:"postgres"`
+	Auth struct {
+		// DevMode allows running without a Telegram bot token for local
+		// development, all authenticated requests are rejected then.
+		DevMode  bool `json:"dev_mode"`
+		Telegram struct {
+			BotToken    string   `json:"bot_token"`
+			InitDataTTL Duration `json:"init_data_ttl"`
+		} `json:"telegram"`
+	} `json:"auth"`
+	Quiz struct {
+		QuestionTTL Duration `json:"question_ttl"`
+		// SessionTTL is how long game sessions are kept after their last
+		// change.
+		SessionTTL Duration `json:"session_ttl"`
+		Pool       struct {
+			Size            int      `json:"size"`
+			RefillThreshold int      `json:"refill_threshold"`
+			Concurrency     int      `json:"concurrency"`
+			RefillInterval  Duration `json:"refill_interval"`
+			// SeenTTL is how long a pooled question is not served again to
+			// the player who got it.
+			SeenTTL Duration `json:"seen_ttl"`
+		} `json:"pool"`
+		QuestionBank struct {
+			Dir string `json:"dir"`
+		} `json:"question_bank"`
+		Grading struct {
+			FuzzyTolerance map[string]int `json:"fuzzy_tolerance"`
+			// TimeLimits per difficulty, difficulties without a value have no
+			// time limit.
+			TimeLimits map[string]Duration `json:"time_limits"`
+			// SpeedBonus is the share of points added for an instant correct
+			// answer, decreasing to zero at the deadline.
+			SpeedBonus float64 `json:"speed_bonus"`
+		} `json:"grading"`
+	} `json:"quiz"`
+	Duel struct {
+		Rounds       uint32   `json:"rounds"`
+		RoundTimeout Duration `json:"round_timeout"`
+		// BotTimeout is how long a player waits for an opponent before a bot
+		// joins, zero disables bots.
+		BotTimeout Duration `json:"bot_timeout"`
+		Bot        struct {
+			MinDelay Duration `json:"min_delay"`
+			MaxDelay Duration `json:"max_delay"`
+			Accuracy float64  `json:"accuracy"`
+		} `json:"bot"`
+	} `json:"duel"`
+	Daily struct {
+		// Enabled serves daily challenges, their questions are drawn from
+		// the question bank.
+		Enabled bool `json:"enabled"`
+		// Seed selects questions of daily challenges, keep it private so
+		// challenges can not be predicted.
+		Seed uint64 `json:"seed"`
+		// Difficulties of challenge questions in order, one question each.
+		Difficulties []string `json:"difficulties"`
+	} `json:"daily"`
+	Achievements struct {
+		// RulesFile is a JSON or YAML file with achievement rules, empty uses
+		// the built-in rules.
+		RulesFile string `json:"rules_file"`
+	} `json:"achievements"`
+	RateLimit struct {
+		// TrustedProxies is the number of proxies in front of the gateway
+		// whose X-Forwarded-For entries are skipped to find the client IP.
+		TrustedProxies int `json:"trusted_proxies"`
+		// PerUser and PerIP map full gRPC method names to rates.
+		PerUser map[string]Rate `json:"per_user"`
+		PerIP   map[string]Rate `json:"per_ip"`
+	} `json:"rate_limit"`
+	Health struct {
+		CheckInterval Duration `json:"check_interval"`
+		CheckTimeout  Duration `json:"check_timeout"`
+	} `json:"health"`
 	Logging struct {
 		Level slog.Level `json:"level"`
+		// Format is either "text" or "json".
+		Format string `json:"format"`
 	} `json:"logging"`
+	Tracing struct {
+		// Exporter is one of "none", "stdout" or "otlp".
+		Exporter    string  `json:"exporter"`
+		Endpoint    string  `json:"endpoint"`
+		Insecure    bool    `json:"insecure"`
+		SampleRatio float64 `json:"sample_ratio"`
+		ServiceName string  `json:"service_name"`
+	} `json:"tracing"`
+	Reload struct {
+		// Interval of polling the override file for changes, zero disables
+		// polling. SIGHUP always triggers a reload.
+		Interval Duration `json:"interval"`
+	} `json:"reload"`
+
+	flags flagValues
 }
 
 func readDefaultConfig() (*Config, error) {
@@ -51,13 +185,40 @@ func readConfigWithOverride(config *Config, fileName string) (*Config, error) {
 		return nil, fmt.Errorf("failed read config %q: %w", fileName, err)
 	}
 
-	if err = json.Unmarshal(data, config); err != nil {
+	switch filepath.Ext(fileName) {
+	case ".yaml", ".yml":
+		if data, err = yamlToJSON(data); err != nil {
+			return nil, fmt.Errorf("failed parse config %q: %w", fileName, err)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err = decoder.Decode(config); err != nil {
 		return nil, fmt.Errorf("failed parse config %q: %w", fileName, err)
 	}
 
 	return config, nil
 }
 
+// yamlToJSON converts YAML to JSON, so both formats share json tags and
+// merge semantics.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var value any
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(value)
+}
+
+// Load builds the config from embedded defaults, the override file,
+// SNIPPET_WAR_* environment variables and flags, in order of precedence.
 func Load() (*Config, error) {
 	config, err := readDefaultConfig()
 	if err != nil {
@@ -65,13 +226,23 @@ func Load() (*Config, error) {
 	}
 
 	flags := readFlags(flagValues{
-		config:  "",
-		verbose: false,
+		config:      "",
+		verbose:     false,
+		printConfig: false,
 
 		grpcAddr: config.Server.GRPC.Addr,
 		httpAddr: config.Server.HTTP.Addr,
 	})
 
+	return load(flags)
+}
+
+func load(flags flagValues) (*Config, error) {
+	config, err := readDefaultConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	if flags.config != "" {
 		config, err = readConfigWithOverride(config, flags.config)
 		if err != nil {
@@ -79,12 +250,82 @@ func Load() (*Config, error) {
 		}
 	}
 
-	config.Server.GRPC.Addr = flags.grpcAddr
-	config.Server.HTTP.Addr = flags.httpAddr
+	// Environment errors, e.g. an unknown log level, are reported together
+	// with invalid fields.
+	envErr := readEnv(config, os.LookupEnv)
+
+	if flags.grpcAddrSet {
+		config.Server.GRPC.Addr = flags.grpcAddr
+	}
+
+	if flags.httpAddrSet {
+		config.Server.HTTP.Addr = flags.httpAddr
+	}
 
 	if flags.verbose {
 		config.Logging.Level = slog.LevelDebug
 	}
 
+	if err = errors.Join(envErr, config.Validate()); err != nil {
+		return nil, fmt.Errorf("invalid config:\n%w", err)
+	}
+
+	config.flags = flags
+
 	return config, nil
 }
+
+// PrintRequested reports whether the service was started with --print-config.
+func (c *Config) PrintRequested() bool {
+	return c.flags.printConfig
+}
+
+// Print writes the config with secrets redacted.
+func (c *Config) Print(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(c.Redacted())
+}
+
+// Redacted returns a copy without secrets: the bot token, the database
+// password and the daily challenge seed.
+func (c *Config) Redacted() *Config {
+	config := *c
+
+	if config.Auth.Telegram.BotToken != "" {
+		config.Auth.Telegram.BotToken = redacted
+	}
+
+	config.Postgres.DSN = redactDSN(config.Postgres.DSN)
+
+	// The seed is a number, it is zeroed instead.
+	config.Daily.Seed = 0
+
+	return &config
+}
+
+var dsnPasswordRe = regexp.MustCompile(`(password\s*=\s*)('(?:[^'\\]|\\.)*'|\S+)`)
+
+// redactDSN hides the password of URL and key/value connection strings.
+func redactDSN(dsn string) string {
+	if dsn == "" {
+		return dsn
+	}
+
+	if dsnURL, err := url.Parse(dsn); err == nil && dsnURL.Scheme != "" {
+		if _, ok := dsnURL.User.Password(); ok {
+			dsnURL.User = url.UserPassword(dsnURL.User.Username(), redacted)
+		}
+
+		query := dsnURL.Query()
+		if query.Has("password") {
+			query.Set("password", redacted)
+			dsnURL.RawQuery = query.Encode()
+		}
+
+		return dsnURL.String()
+	}
+
+	return dsnPasswordRe.ReplaceAllString(dsn, "${1}"+redacted)
+}
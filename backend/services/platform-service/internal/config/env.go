@@ -0,0 +1,140 @@
+package config
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const envPrefix = "SNIPPET_WAR"
+
+// readEnv overrides config fields from environment variables named after the
+// json path of a field, e.g. SNIPPET_WAR_CONTENT_SERVICE_RETRY_MAX_ATTEMPTS.
+// Maps are passed as "key=value,key=value", slices as "value,value".
+func readEnv(config *Config, lookup func(key string) (string, bool)) error {
+	return readEnvStruct(reflect.ValueOf(config).Elem(), envPrefix, lookup)
+}
+
+func readEnvStruct(value reflect.Value, prefix string, lookup func(key string) (string, bool)) error {
+	var errs []error
+
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		key := prefix + "_" + strings.ToUpper(name)
+		fieldValue := value.Field(i)
+
+		if fieldValue.Kind() == reflect.Struct && !isTextUnmarshaler(fieldValue) {
+			errs = append(errs, readEnvStruct(fieldValue, key, lookup))
+			continue
+		}
+
+		env, ok := lookup(key)
+		if !ok {
+			continue
+		}
+
+		if err := setValue(fieldValue, env); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func isTextUnmarshaler(value reflect.Value) bool {
+	_, ok := value.Addr().Interface().(encoding.TextUnmarshaler)
+	return ok
+}
+
+func setValue(value reflect.Value, env string) error {
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(env))
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(env)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(env)
+		if err != nil {
+			return fmt.Errorf("invalid bool %q", env)
+		}
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(env, 10, value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", env)
+		}
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(env, 10, value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid unsigned integer %q", env)
+		}
+		value.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(env, value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid number %q", env)
+		}
+		value.SetFloat(parsed)
+	case reflect.Slice:
+		slice := reflect.MakeSlice(value.Type(), 0, 0)
+		for _, item := range splitList(env) {
+			elem := reflect.New(value.Type().Elem()).Elem()
+			if err := setValue(elem, item); err != nil {
+				return err
+			}
+			slice = reflect.Append(slice, elem)
+		}
+		value.Set(slice)
+	case reflect.Map:
+		m := reflect.MakeMap(value.Type())
+		for _, item := range splitList(env) {
+			k, v, ok := strings.Cut(item, "=")
+			if !ok {
+				return fmt.Errorf("invalid map entry %q, expected key=value", item)
+			}
+
+			key := reflect.New(value.Type().Key()).Elem()
+			if err := setValue(key, strings.TrimSpace(k)); err != nil {
+				return err
+			}
+
+			elem := reflect.New(value.Type().Elem()).Elem()
+			if err := setValue(elem, strings.TrimSpace(v)); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(key, elem)
+		}
+		value.Set(m)
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+
+	return nil
+}
+
+func splitList(env string) []string {
+	var items []string
+	for item := range strings.SplitSeq(env, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -3,10 +3,14 @@ package config
 import "flag"
 
 type flagValues struct {
-	config   string
-	verbose  bool
-	grpcAddr string
-	httpAddr string
+	config      string
+	verbose     bool
+	printConfig bool
+	grpcAddr    string
+	httpAddr    string
+
+	grpcAddrSet bool
+	httpAddrSet bool
 }
 
 func readFlags(values flagValues) flagValues {
@@ -15,8 +19,23 @@ func readFlags(values flagValues) flagValues {
 	flag.StringVar(&values.grpcAddr, "grpc_addr", values.grpcAddr, "grpc server address")
 	flag.StringVar(&values.httpAddr, "http_addr", values.httpAddr, "http server address")
 	flag.BoolVar(&values.verbose, "verbose", values.verbose, "enable verbose logging")
+	flag.BoolVar(&values.printConfig, "print-config", values.printConfig, "print effective config with secrets redacted and exit")
 
 	flag.Parse()
 
+	values.grpcAddrSet = isFlagSet("grpc_addr")
+	values.httpAddrSet = isFlagSet("http_addr")
+
 	return values
 }
+
+func isFlagSet(name string) bool {
+	var set bool
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
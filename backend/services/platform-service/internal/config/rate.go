@@ -0,0 +1,46 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rate is a number of events per period written as "30/m", "5/s" or
+// "100/10m".
+type Rate struct {
+	Count  int
+	Period time.Duration
+}
+
+func (r Rate) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d/%s", r.Count, r.Period)), nil
+}
+
+func (r *Rate) UnmarshalText(text []byte) error {
+	count, period, ok := strings.Cut(string(text), "/")
+	if !ok {
+		return fmt.Errorf("invalid rate %q, expected count/period", text)
+	}
+
+	parsedCount, err := strconv.Atoi(strings.TrimSpace(count))
+	if err != nil || parsedCount <= 0 {
+		return fmt.Errorf("invalid rate %q: count must be a positive integer", text)
+	}
+
+	period = strings.TrimSpace(period)
+	switch period {
+	case "s", "m", "h":
+		period = "1" + period
+	}
+
+	parsedPeriod, err := time.ParseDuration(period)
+	if err != nil || parsedPeriod <= 0 {
+		return fmt.Errorf("invalid rate %q: period must be a positive duration", text)
+	}
+
+	*r = Rate{Count: parsedCount, Period: parsedPeriod}
+
+	return nil
+}
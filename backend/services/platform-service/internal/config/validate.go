@@ -0,0 +1,145 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Validate reports all invalid fields at once.
+func (c *Config) Validate() error {
+	var errs []error
+
+	check := func(ok bool, field, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", field, fmt.Sprintf(format, args...)))
+		}
+	}
+
+	checkAddr := func(field, addr string) {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid address %q: %w", field, addr, err))
+		}
+	}
+
+	checkPositive := func(field string, d Duration) {
+		check(d > 0, field, "must be positive, got %s", time.Duration(d))
+	}
+
+	checkAddr("server.grpc.addr", c.Server.GRPC.Addr)
+	checkAddr("server.http.addr", c.Server.HTTP.Addr)
+	checkPositive("server.shutdown_timeout", c.Server.ShutdownTimeout)
+	for _, origin := range c.Server.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+
+		originURL, err := url.Parse(origin)
+		check(
+			err == nil && originURL.Scheme != "" && originURL.Host != "" && originURL.Path == "",
+			"server.cors.allowed_origins", "invalid origin %q", origin,
+		)
+	}
+	check(c.Server.CORS.MaxAge >= 0, "server.cors.max_age", "must not be negative")
+
+	if c.Frontend.Dir != "" {
+		info, err := os.Stat(c.Frontend.Dir)
+		check(err == nil && info.IsDir(), "frontend.dir", "%q is not a directory", c.Frontend.Dir)
+	}
+	check(c.Frontend.CacheMaxAge >= 0, "frontend.cache_max_age", "must not be negative")
+
+	contentServiceURL, err := url.Parse(c.ContentService.Addr)
+	check(
+		err == nil && (contentServiceURL.Scheme == "http" || contentServiceURL.Scheme == "https") && contentServiceURL.Host != "",
+		"content_service.addr", "invalid url %q", c.ContentService.Addr,
+	)
+	checkPositive("content_service.timeout", c.ContentService.Timeout)
+	check(c.ContentService.Retry.MaxAttempts >= 1, "content_service.retry.max_attempts", "must be at least 1")
+	check(c.ContentService.Retry.InitialBackoff >= 0, "content_service.retry.initial_backoff", "must not be negative")
+	check(
+		c.ContentService.Retry.MaxBackoff >= c.ContentService.Retry.InitialBackoff,
+		"content_service.retry.max_backoff", "must not be less than initial_backoff",
+	)
+	check(c.ContentService.CircuitBreaker.FailureThreshold >= 0, "content_service.circuit_breaker.failure_threshold", "must not be negative")
+	checkPositive("content_service.circuit_breaker.open_timeout", c.ContentService.CircuitBreaker.OpenTimeout)
+
+	if c.Postgres.DSN != "" {
+		check(c.Postgres.MaxConns >= 1, "postgres.max_conns", "must be at least 1")
+	}
+
+	check(c.Auth.DevMode || c.Auth.Telegram.BotToken != "", "auth.telegram.bot_token", "is required outside of auth.dev_mode")
+	checkPositive("auth.telegram.init_data_ttl", c.Auth.Telegram.InitDataTTL)
+
+	checkPositive("quiz.question_ttl", c.Quiz.QuestionTTL)
+	checkPositive("quiz.session_ttl", c.Quiz.SessionTTL)
+	check(c.Quiz.Pool.Size >= 0, "quiz.pool.size", "must not be negative")
+	if c.Quiz.Pool.Size > 0 {
+		check(
+			c.Quiz.Pool.RefillThreshold >= 0 && c.Quiz.Pool.RefillThreshold <= c.Quiz.Pool.Size,
+			"quiz.pool.refill_threshold", "must be between 0 and pool size",
+		)
+		check(c.Quiz.Pool.Concurrency >= 1, "quiz.pool.concurrency", "must be at least 1")
+		checkPositive("quiz.pool.refill_interval", c.Quiz.Pool.RefillInterval)
+		checkPositive("quiz.pool.seen_ttl", c.Quiz.Pool.SeenTTL)
+	}
+	for language, tolerance := range c.Quiz.Grading.FuzzyTolerance {
+		check(tolerance >= 0, "quiz.grading.fuzzy_tolerance."+language, "must not be negative")
+	}
+	for difficulty, limit := range c.Quiz.Grading.TimeLimits {
+		switch difficulty {
+		case "beginner", "intermediate", "advanced":
+			check(limit >= 0, "quiz.grading.time_limits."+difficulty, "must not be negative")
+		default:
+			check(false, "quiz.grading.time_limits", "unknown difficulty %q", difficulty)
+		}
+	}
+	check(c.Quiz.Grading.SpeedBonus >= 0, "quiz.grading.speed_bonus", "must not be negative")
+
+	check(c.Duel.Rounds >= 1, "duel.rounds", "must be at least 1")
+	checkPositive("duel.round_timeout", c.Duel.RoundTimeout)
+	check(c.Duel.BotTimeout >= 0, "duel.bot_timeout", "must not be negative")
+	if c.Duel.BotTimeout > 0 {
+		check(c.Duel.Bot.MinDelay >= 0, "duel.bot.min_delay", "must not be negative")
+		check(c.Duel.Bot.MaxDelay >= c.Duel.Bot.MinDelay, "duel.bot.max_delay", "must not be less than min_delay")
+		check(c.Duel.Bot.Accuracy >= 0 && c.Duel.Bot.Accuracy <= 1, "duel.bot.accuracy", "must be between 0 and 1")
+	}
+
+	if c.Daily.Enabled {
+		check(c.Daily.Seed != 0, "daily.seed", "must be set to a private non-zero value")
+		check(c.Quiz.QuestionBank.Dir != "", "quiz.question_bank.dir", "must be set to draw daily challenges from")
+	}
+	check(len(c.Daily.Difficulties) >= 1, "daily.difficulties", "must not be empty")
+	for _, difficulty := range c.Daily.Difficulties {
+		check(
+			difficulty == "beginner" || difficulty == "intermediate" || difficulty == "advanced",
+			"daily.difficulties", "unknown difficulty %q", difficulty,
+		)
+	}
+
+	check(c.RateLimit.TrustedProxies >= 0, "rate_limit.trusted_proxies", "must not be negative")
+
+	checkPositive("health.check_interval", c.Health.CheckInterval)
+	checkPositive("health.check_timeout", c.Health.CheckTimeout)
+
+	check(
+		c.Logging.Format == "text" || c.Logging.Format == "json",
+		"logging.format", "unknown format %q, expected text or json", c.Logging.Format,
+	)
+
+	switch c.Tracing.Exporter {
+	case "none":
+	case "stdout":
+	case "otlp":
+		checkAddr("tracing.endpoint", c.Tracing.Endpoint)
+	default:
+		check(false, "tracing.exporter", "unknown exporter %q, expected none, stdout or otlp", c.Tracing.Exporter)
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio", "must be between 0 and 1")
+
+	check(c.Reload.Interval >= 0, "reload.interval", "must not be negative")
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"context"
+	"encoding"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// reloadable lists json paths of fields that are applied without a restart.
+// Changes to any other field are rejected on reload.
+var reloadable = []string{
+	"server.cors",
+	"content_service.timeout",
+	"content_service.retry",
+	"content_service.circuit_breaker",
+	"quiz.grading",
+	"logging.level",
+	"rate_limit.per_user",
+	"rate_limit.per_ip",
+}
+
+// Watcher reloads the config on SIGHUP and when the override file changes and
+// publishes every new snapshot to subscribers. Snapshots must not be modified.
+type Watcher struct {
+	current atomic.Pointer[Config]
+
+	mu          sync.Mutex
+	subscribers []func(config *Config)
+
+	modTime time.Time
+	size    int64
+}
+
+func NewWatcher(config *Config) *Watcher {
+	w := &Watcher{}
+	w.current.Store(config)
+	w.fileChanged()
+
+	return w
+}
+
+func (w *Watcher) Current() *Config {
+	return w.current.Load()
+}
+
+func (w *Watcher) Subscribe(fn func(config *Config)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.subscribers = append(w.subscribers, fn)
+}
+
+// Run watches for changes until ctx is done. The override file is polled
+// with the reload interval, zero disables polling.
+func (w *Watcher) Run(ctx context.Context) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	var poll <-chan time.Time
+	if interval := w.Current().Reload.Interval.Duration(); interval > 0 && w.Current().flags.config != "" {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		poll = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-signals:
+			w.reload(ctx, "signal")
+		case <-poll:
+			if w.fileChanged() {
+				w.reload(ctx, "file")
+			}
+		}
+	}
+}
+
+// Reload loads a new snapshot and notifies subscribers if it differs from
+// the current one.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	current := w.current.Load()
+
+	next, err := load(current.flags)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range restoreUnsafe(next, current) {
+		slog.Warn("config field cannot be reloaded, restart to apply it", "field", field)
+	}
+
+	if reflect.DeepEqual(next, current) {
+		return nil
+	}
+
+	w.current.Store(next)
+
+	for _, fn := range w.subscribers {
+		fn(next)
+	}
+
+	return nil
+}
+
+func (w *Watcher) reload(ctx context.Context, trigger string) {
+	if err := w.Reload(); err != nil {
+		slog.ErrorContext(ctx, "failed reload config, keeping current", "trigger", trigger, "error", err)
+		return
+	}
+
+	slog.InfoContext(ctx, "config reloaded", "trigger", trigger)
+}
+
+func (w *Watcher) fileChanged() bool {
+	fileName := w.Current().flags.config
+	if fileName == "" {
+		return false
+	}
+
+	info, err := os.Stat(fileName)
+	if err != nil {
+		return false
+	}
+
+	if info.ModTime().Equal(w.modTime) && info.Size() == w.size {
+		return false
+	}
+
+	w.modTime, w.size = info.ModTime(), info.Size()
+
+	return true
+}
+
+// restoreUnsafe copies fields that cannot be reloaded from current to next and
+// returns json paths of those that were changed.
+func restoreUnsafe(next, current *Config) []string {
+	return restoreUnsafeStruct(reflect.ValueOf(next).Elem(), reflect.ValueOf(current).Elem(), "")
+}
+
+func restoreUnsafeStruct(next, current reflect.Value, prefix string) []string {
+	var rejected []string
+
+	for i := range next.NumField() {
+		field := next.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		path := prefix + name
+
+		if isReloadable(path) {
+			continue
+		}
+
+		nextValue, currentValue := next.Field(i), current.Field(i)
+
+		if nextValue.Kind() == reflect.Struct {
+			if _, ok := nextValue.Addr().Interface().(encoding.TextUnmarshaler); !ok {
+				rejected = append(rejected, restoreUnsafeStruct(nextValue, currentValue, path+".")...)
+				continue
+			}
+		}
+
+		if !reflect.DeepEqual(nextValue.Interface(), currentValue.Interface()) {
+			nextValue.Set(currentValue)
+			rejected = append(rejected, path)
+		}
+	}
+
+	return rejected
+}
+
+func isReloadable(path string) bool {
+	for _, field := range reloadable {
+		if path == field || strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeOverride(t *testing.T, fileName, data string) {
+	t.Helper()
+
+	if err := os.WriteFile(fileName, []byte(data), 0o600); err != nil {
+		t.Fatalf("write override: %v", err)
+	}
+}
+
+func newTestWatcher(t *testing.T, override string) (*Watcher, string, chan *Config) {
+	t.Helper()
+
+	t.Setenv("SNIPPET_WAR_AUTH_TELEGRAM_BOT_TOKEN", "123456:test-token")
+
+	fileName := filepath.Join(t.TempDir(), "config.yaml")
+	writeOverride(t, fileName, override)
+
+	config, err := load(flagValues{config: fileName})
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	w := NewWatcher(config)
+
+	reloaded := make(chan *Config, 10)
+	w.Subscribe(func(config *Config) {
+		reloaded <- config
+	})
+
+	return w, fileName, reloaded
+}
+
+func TestReloadAppliesValidChange(t *testing.T) {
+	w, fileName, reloaded := newTestWatcher(t, `
+server:
+  http:
+    addr: 127.0.0.1:8088
+quiz:
+  grading:
+    speed_bonus: 0.2
+`)
+
+	writeOverride(t, fileName, `
+server:
+  http:
+    addr: 127.0.0.1:9099
+quiz:
+  grading:
+    speed_bonus: 0.5
+`)
+
+	if err := w.Reload(); err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+
+	current := w.Current()
+	if current.Quiz.Grading.SpeedBonus != 0.5 {
+		t.Errorf("got speed bonus %v, want 0.5", current.Quiz.Grading.SpeedBonus)
+	}
+
+	// The address is only applied on restart.
+	if current.Server.HTTP.Addr != "127.0.0.1:8088" {
+		t.Errorf("got http addr %q, want the address loaded on start", current.Server.HTTP.Addr)
+	}
+
+	select {
+	case config := <-reloaded:
+		if config != current {
+			t.Error("subscriber got another snapshot than the current one")
+		}
+	default:
+		t.Error("subscriber is not notified")
+	}
+
+	// Reloading the same file changes nothing.
+	if err := w.Reload(); err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if len(reloaded) != 0 {
+		t.Error("subscriber is notified without a change")
+	}
+}
+
+func TestReloadRejectsInvalidChange(t *testing.T) {
+	w, fileName, reloaded := newTestWatcher(t, `
+quiz:
+  grading:
+    speed_bonus: 0.2
+`)
+	previous := w.Current()
+
+	for _, tt := range []struct {
+		name     string
+		override string
+		want     string
+	}{
+		{
+			name:     "invalid value",
+			override: "quiz:\n  grading:\n    speed_bonus: -1\n",
+			want:     "quiz.grading.speed_bonus",
+		},
+		{
+			name:     "unknown field",
+			override: "quiz:\n  grading:\n    speed_bonu: 0.5\n",
+			want:     "speed_bonu",
+		},
+		{
+			name:     "malformed file",
+			override: "quiz: [\n",
+			want:     "failed parse config",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			writeOverride(t, fileName, tt.override)
+
+			err := w.Reload()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got error %v, want one mentioning %q", err, tt.want)
+			}
+
+			if w.Current() != previous {
+				t.Error("current config is replaced")
+			}
+			if len(reloaded) != 0 {
+				t.Error("subscriber is notified")
+			}
+		})
+	}
+}
+
+func TestRunReloadsChangedFile(t *testing.T) {
+	w, fileName, reloaded := newTestWatcher(t, `
+reload:
+  interval: 10ms
+quiz:
+  grading:
+    speed_bonus: 0.2
+`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- w.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	// The size changes too, modification times may be coarse.
+	writeOverride(t, fileName, `
+reload:
+  interval: 10ms
+quiz:
+  grading:
+    speed_bonus: 0.75
+`)
+
+	select {
+	case config := <-reloaded:
+		if config.Quiz.Grading.SpeedBonus != 0.75 {
+			t.Errorf("got speed bonus %v, want 0.75", config.Quiz.Grading.SpeedBonus)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("changed file is not reloaded")
+	}
+}
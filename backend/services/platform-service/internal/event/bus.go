@@ -0,0 +1,42 @@
+package event
+
+import (
+	"context"
+	"sync"
+)
+
+type Event interface {
+	EventName() string
+}
+
+type Handler func(ctx context.Context, event Event)
+
+// Bus dispatches gameplay events to subscribers synchronously, in the order
+// they subscribed.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+func (b *Bus) Subscribe(name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	b.mu.RLock()
+	handlers := b.handlers[event.EventName()]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(ctx, event)
+	}
+}
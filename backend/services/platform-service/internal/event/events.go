@@ -0,0 +1,75 @@
+package event
+
+import (
+	"time"
+
+	achievement_models "github.com/casnerano/snippet-war/internal/model/achievement"
+	daily_models "github.com/casnerano/snippet-war/internal/model/daily"
+	duel_models "github.com/casnerano/snippet-war/internal/model/duel"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	session_models "github.com/casnerano/snippet-war/internal/model/session"
+)
+
+const AnswerGradedName = "answer_graded"
+
+type AnswerGraded struct {
+	UserID      int64
+	DisplayName string
+	Question    *quiz_models.Question
+	Result      *quiz_models.AnswerResult
+	GradedAt    time.Time
+}
+
+func (AnswerGraded) EventName() string {
+	return AnswerGradedName
+}
+
+const DuelFinishedName = "duel_finished"
+
+type DuelFinished struct {
+	Duel       *duel_models.Duel
+	FinishedAt time.Time
+}
+
+func (DuelFinished) EventName() string {
+	return DuelFinishedName
+}
+
+const StreakExtendedName = "streak_extended"
+
+type StreakExtended struct {
+	UserID      int64
+	DisplayName string
+	Streak      *daily_models.Streak
+	ExtendedAt  time.Time
+}
+
+func (StreakExtended) EventName() string {
+	return StreakExtendedName
+}
+
+const SessionFinishedName = "session_finished"
+
+type SessionFinished struct {
+	UserID      int64
+	DisplayName string
+	Session     *session_models.Session
+	FinishedAt  time.Time
+}
+
+func (SessionFinished) EventName() string {
+	return SessionFinishedName
+}
+
+const AchievementUnlockedName = "achievement_unlocked"
+
+type AchievementUnlocked struct {
+	UserID      int64
+	DisplayName string
+	Achievement *achievement_models.Achievement
+	UnlockedAt  time.Time
+}
+
+func (AchievementUnlocked) EventName() string {
+	return AchievementUnlockedName
+}
@@ -0,0 +1,97 @@
+package frontend
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const indexFile = "index.html"
+
+// hashedName matches build artifacts with a content hash in the name, like
+// app.3f2a1b9c.js or chunk-5d41402a.css, which never change once published.
+var hashedName = regexp.MustCompile(`[.-][0-9a-fA-F]{8,}\.[^/]+$`)
+
+type Options struct {
+	// CacheMaxAge is the cache lifetime of assets without a content hash.
+	CacheMaxAge time.Duration
+}
+
+// Handler serves a single page application from fsys, either a directory or
+// embedded assets. Paths without an extension that do not match a file fall
+// back to index.html, so client side routes survive a page reload.
+type Handler struct {
+	fsys       fs.FS
+	fileServer http.Handler
+	options    Options
+}
+
+func New(fsys fs.FS, options Options) (*Handler, error) {
+	if _, err := fs.Stat(fsys, indexFile); err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", indexFile, err)
+	}
+
+	return &Handler{
+		fsys:       fsys,
+		fileServer: http.FileServerFS(fsys),
+		options:    options,
+	}, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	if name == "" {
+		name = indexFile
+	}
+
+	info, err := fs.Stat(h.fsys, name)
+	switch {
+	case err == nil && !info.IsDir():
+	case err == nil:
+		name = path.Join(name, indexFile)
+		if _, err = fs.Stat(h.fsys, name); err != nil {
+			name = indexFile
+		}
+	case errors.Is(err, fs.ErrNotExist) && path.Ext(name) == "":
+		name = indexFile
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Cache-Control", h.cacheControl(name))
+
+	if path.Base(name) == indexFile {
+		// FileServer would look up the request path again, which does not
+		// exist for fallback routes.
+		http.ServeFileFS(w, r, h.fsys, name)
+		return
+	}
+
+	r.URL.Path = "/" + name
+	h.fileServer.ServeHTTP(w, r)
+}
+
+func (h *Handler) cacheControl(name string) string {
+	switch {
+	case path.Base(name) == indexFile:
+		return "no-cache"
+	case hashedName.MatchString(name):
+		return "public, max-age=31536000, immutable"
+	case h.options.CacheMaxAge > 0:
+		return fmt.Sprintf("public, max-age=%d", int(h.options.CacheMaxAge.Seconds()))
+	default:
+		return "no-cache"
+	}
+}
@@ -0,0 +1,43 @@
+package achievement
+
+import (
+	"context"
+	"log/slog"
+
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	achievement_models "github.com/casnerano/snippet-war/internal/model/achievement"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/achievement"
+	"google.golang.org/grpc/status"
+)
+
+type achievementService interface {
+	DefaultLocale() string
+	ListAchievements(ctx context.Context) ([]*achievement_models.Achievement, error)
+}
+
+type Achievements struct {
+	desc.UnimplementedAchievementsServer
+
+	achievementService achievementService
+}
+
+func NewAchievements(achievementService achievementService) *Achievements {
+	return &Achievements{
+		achievementService: achievementService,
+	}
+}
+
+func (a *Achievements) ListAchievements(ctx context.Context, request *desc.ListAchievements_Request) (*desc.ListAchievements_Response, error) {
+	achievements, err := a.achievementService.ListAchievements(ctx)
+	if err != nil {
+		statusCode, logLevel := quiz_handler.ErrorToStatus(err)
+
+		slog.Log(ctx, logLevel, "failed list achievements", "error", err, "code", statusCode.String())
+
+		return nil, status.Error(statusCode, statusCode.String())
+	}
+
+	return &desc.ListAchievements_Response{
+		Achievements: AchievementsToProto(achievements, request.Locale, a.achievementService.DefaultLocale()),
+	}, nil
+}
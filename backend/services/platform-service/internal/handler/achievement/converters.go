@@ -0,0 +1,32 @@
+package achievement
+
+import (
+	models "github.com/casnerano/snippet-war/internal/model/achievement"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/achievement"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AchievementsToProto(achievements []*models.Achievement, locale, defaultLocale string) []*desc.Achievement {
+	if len(achievements) == 0 {
+		return nil
+	}
+
+	pbAchievements := make([]*desc.Achievement, 0, len(achievements))
+	for _, a := range achievements {
+		pb := &desc.Achievement{
+			Id:          a.Rule.ID,
+			Name:        a.Rule.Names.Get(locale, defaultLocale),
+			Description: a.Rule.Descriptions.Get(locale, defaultLocale),
+			Count:       a.Count,
+			Target:      a.Rule.Count,
+		}
+
+		if a.UnlockedAt != nil {
+			pb.UnlockedAt = timestamppb.New(*a.UnlockedAt)
+		}
+
+		pbAchievements = append(pbAchievements, pb)
+	}
+
+	return pbAchievements
+}
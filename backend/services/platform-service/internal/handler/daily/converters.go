@@ -0,0 +1,65 @@
+package daily
+
+import (
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	models "github.com/casnerano/snippet-war/internal/model/daily"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/daily"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AttemptToProto(attempt *models.Attempt) *desc.Attempt {
+	if attempt == nil {
+		return nil
+	}
+
+	pb := &desc.Attempt{
+		Date:           timestamppb.New(attempt.Date),
+		Language:       quiz_handler.LanguageToProto(attempt.Language),
+		Questions:      quiz_handler.QuestionsToProto(attempt.Questions),
+		Answers:        AnswersToProto(attempt.Answers),
+		Score:          attempt.Score,
+		CorrectAnswers: attempt.CorrectAnswers,
+		StartedAt:      timestamppb.New(attempt.StartedAt),
+	}
+
+	if attempt.FinishedAt != nil {
+		pb.FinishedAt = timestamppb.New(*attempt.FinishedAt)
+	}
+
+	return pb
+}
+
+func AnswersToProto(answers []*models.Answer) []*desc.Answer {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	pbAnswers := make([]*desc.Answer, 0, len(answers))
+	for _, answer := range answers {
+		pbAnswers = append(pbAnswers, &desc.Answer{
+			QuestionId: answer.QuestionID,
+			Correct:    answer.Correct,
+			Late:       answer.Late,
+			Points:     answer.Points,
+		})
+	}
+
+	return pbAnswers
+}
+
+func StreakToProto(streak *models.Streak) *desc.Streak {
+	if streak == nil {
+		return nil
+	}
+
+	pb := &desc.Streak{
+		Current: streak.Current,
+		Best:    streak.Best,
+	}
+
+	if !streak.LastDate.IsZero() {
+		pb.LastDate = timestamppb.New(streak.LastDate)
+	}
+
+	return pb
+}
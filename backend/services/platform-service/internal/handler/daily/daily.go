@@ -0,0 +1,120 @@
+package daily
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	leaderboard_handler "github.com/casnerano/snippet-war/internal/handler/leaderboard"
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	daily_models "github.com/casnerano/snippet-war/internal/model/daily"
+	leaderboard_models "github.com/casnerano/snippet-war/internal/model/leaderboard"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	daily_service "github.com/casnerano/snippet-war/internal/service/daily"
+	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/daily"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type dailyService interface {
+	GetChallenge(ctx context.Context, language quiz_models.Language) (*daily_models.Progress, error)
+	StartChallenge(ctx context.Context, language quiz_models.Language) (*daily_models.Progress, error)
+	SubmitAnswer(ctx context.Context, language quiz_models.Language, args quiz_service.SubmitAnswerArgs) (*daily_models.Progress, *quiz_models.AnswerResult, error)
+	GetLeaderboard(ctx context.Context, args daily_service.GetLeaderboardArgs) (time.Time, *leaderboard_models.Leaderboard, error)
+}
+
+type DailyChallenge struct {
+	desc.UnimplementedDailyChallengeServer
+
+	dailyService dailyService
+}
+
+func NewDailyChallenge(dailyService dailyService) *DailyChallenge {
+	return &DailyChallenge{
+		dailyService: dailyService,
+	}
+}
+
+func (d *DailyChallenge) GetDailyChallenge(ctx context.Context, request *desc.GetDailyChallenge_Request) (*desc.GetDailyChallenge_Response, error) {
+	progress, err := d.dailyService.GetChallenge(ctx, quiz_handler.ProtoToLanguage(request.Language))
+	if err != nil {
+		return nil, toStatusError(ctx, "failed get daily challenge", err)
+	}
+
+	return &desc.GetDailyChallenge_Response{
+		Attempt: AttemptToProto(progress.Attempt),
+		Streak:  StreakToProto(progress.Streak),
+	}, nil
+}
+
+func (d *DailyChallenge) StartDailyChallenge(ctx context.Context, request *desc.StartDailyChallenge_Request) (*desc.StartDailyChallenge_Response, error) {
+	progress, err := d.dailyService.StartChallenge(ctx, quiz_handler.ProtoToLanguage(request.Language))
+	if err != nil {
+		return nil, toStatusError(ctx, "failed start daily challenge", err)
+	}
+
+	return &desc.StartDailyChallenge_Response{
+		Attempt: AttemptToProto(progress.Attempt),
+		Streak:  StreakToProto(progress.Streak),
+	}, nil
+}
+
+func (d *DailyChallenge) SubmitAnswer(ctx context.Context, request *desc.SubmitAnswer_Request) (*desc.SubmitAnswer_Response, error) {
+	progress, result, err := d.dailyService.SubmitAnswer(ctx, quiz_handler.ProtoToLanguage(request.Language), quiz_service.SubmitAnswerArgs{
+		QuestionID: request.GetAnswer().GetQuestionId(),
+		Answer:     quiz_handler.ProtoToUserAnswer(request.GetAnswer()),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, "failed submit daily challenge answer", err)
+	}
+
+	return &desc.SubmitAnswer_Response{
+		Attempt: AttemptToProto(progress.Attempt),
+		Streak:  StreakToProto(progress.Streak),
+		Result:  quiz_handler.AnswerResultToProto(result),
+	}, nil
+}
+
+func (d *DailyChallenge) GetDailyLeaderboard(ctx context.Context, request *desc.GetDailyLeaderboard_Request) (*desc.GetDailyLeaderboard_Response, error) {
+	args := daily_service.GetLeaderboardArgs{
+		Language: quiz_handler.ProtoToLanguage(request.Language),
+		Limit:    request.Limit,
+	}
+
+	if request.Date != nil {
+		args.Date = request.Date.AsTime()
+	}
+
+	date, leaderboard, err := d.dailyService.GetLeaderboard(ctx, args)
+	if err != nil {
+		return nil, toStatusError(ctx, "failed get daily leaderboard", err)
+	}
+
+	return &desc.GetDailyLeaderboard_Response{
+		Date:    timestamppb.New(date),
+		Entries: leaderboard_handler.EntriesToProto(leaderboard.Entries),
+		Me:      leaderboard_handler.EntryToProto(leaderboard.Me),
+	}, nil
+}
+
+func toStatusError(ctx context.Context, msg string, err error) error {
+	statusCode, logLevel := quiz_handler.ErrorToStatus(err)
+
+	switch {
+	case errors.Is(err, daily_models.ErrAttemptNotFound),
+		errors.Is(err, daily_models.ErrQuestionNotInSet):
+		statusCode, logLevel = codes.NotFound, slog.LevelInfo
+	case errors.Is(err, daily_models.ErrAttemptFinished),
+		errors.Is(err, daily_models.ErrAlreadyAnswered):
+		statusCode, logLevel = codes.FailedPrecondition, slog.LevelInfo
+	case errors.Is(err, daily_models.ErrNoQuestions):
+		statusCode, logLevel = codes.Unavailable, slog.LevelWarn
+	}
+
+	slog.Log(ctx, logLevel, msg, "error", err, "code", statusCode.String())
+
+	return status.Error(statusCode, statusCode.String())
+}
@@ -0,0 +1,159 @@
+package duel
+
+import (
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	models "github.com/casnerano/snippet-war/internal/model/duel"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/duel"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EventToProto converts the event for the player with userID, who gets the
+// outcome of a finished duel.
+func EventToProto(event *models.Event, userID int64) *desc.Duel_Event {
+	if event == nil {
+		return nil
+	}
+
+	pb := &desc.Duel_Event{
+		Type:  EventTypeToProto(event.Type),
+		Match: MatchToProto(event.Duel),
+		Round: RoundToProto(event.Round),
+	}
+
+	if event.Duel != nil && event.Duel.Status == models.StatusFinished {
+		pb.Outcome = OutcomeToProto(event.Duel, userID)
+	}
+
+	return pb
+}
+
+func MatchToProto(duel *models.Duel) *desc.Match {
+	if duel == nil {
+		return nil
+	}
+
+	pb := &desc.Match{
+		Id:          duel.ID,
+		Status:      StatusToProto(duel.Status),
+		Language:    quiz_handler.LanguageToProto(duel.Language),
+		Topics:      duel.Topics,
+		Difficulty:  quiz_handler.DifficultyToProto(duel.Difficulty),
+		Players:     PlayersToProto(duel.Players),
+		TotalRounds: duel.TotalRounds,
+		WinnerId:    duel.WinnerID,
+		StartedAt:   timestamppb.New(duel.StartedAt),
+	}
+
+	if duel.FinishedAt != nil {
+		pb.FinishedAt = timestamppb.New(*duel.FinishedAt)
+	}
+
+	return pb
+}
+
+func PlayersToProto(players []*models.Player) []*desc.Player {
+	if len(players) == 0 {
+		return nil
+	}
+
+	pbPlayers := make([]*desc.Player, 0, len(players))
+	for _, p := range players {
+		pbPlayers = append(pbPlayers, &desc.Player{
+			UserId:         p.UserID,
+			DisplayName:    p.DisplayName,
+			Bot:            p.Bot,
+			CorrectAnswers: p.CorrectAnswers,
+			AnswerTime:     durationpb.New(p.AnswerTime),
+		})
+	}
+
+	return pbPlayers
+}
+
+func RoundToProto(round *models.Round) *desc.Round {
+	if round == nil {
+		return nil
+	}
+
+	pb := &desc.Round{
+		Number:   round.Number,
+		Question: quiz_handler.QuestionToProto(round.Question),
+		IssuedAt: timestamppb.New(round.IssuedAt),
+		Deadline: timestamppb.New(round.Deadline),
+	}
+
+	if round.Finished {
+		pb.Answers = AnswersToProto(round.Answers)
+		pb.CorrectAnswers = round.Question.CorrectAnswers()
+	}
+
+	return pb
+}
+
+func AnswerToProto(answer *models.Answer) *desc.Answer {
+	if answer == nil {
+		return nil
+	}
+
+	return &desc.Answer{
+		UserId:   answer.UserID,
+		Answered: answer.Answered,
+		Correct:  answer.Correct,
+		Elapsed:  durationpb.New(answer.Elapsed),
+	}
+}
+
+func AnswersToProto(answers []*models.Answer) []*desc.Answer {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	pbAnswers := make([]*desc.Answer, 0, len(answers))
+	for _, a := range answers {
+		pbAnswers = append(pbAnswers, AnswerToProto(a))
+	}
+
+	return pbAnswers
+}
+
+func EventTypeToProto(eventType models.EventType) desc.Duel_Event_Type {
+	switch eventType {
+	case models.EventTypeWaiting:
+		return desc.Duel_Event_TYPE_WAITING
+	case models.EventTypeMatched:
+		return desc.Duel_Event_TYPE_MATCHED
+	case models.EventTypeQuestion:
+		return desc.Duel_Event_TYPE_QUESTION
+	case models.EventTypeRoundFinished:
+		return desc.Duel_Event_TYPE_ROUND_FINISHED
+	case models.EventTypeFinished:
+		return desc.Duel_Event_TYPE_FINISHED
+	default:
+		return desc.Duel_Event_TYPE_UNSPECIFIED
+	}
+}
+
+func StatusToProto(status models.Status) desc.Match_Status {
+	switch status {
+	case models.StatusActive:
+		return desc.Match_STATUS_ACTIVE
+	case models.StatusFinished:
+		return desc.Match_STATUS_FINISHED
+	case models.StatusAborted:
+		return desc.Match_STATUS_ABORTED
+	default:
+		return desc.Match_STATUS_UNSPECIFIED
+	}
+}
+
+func OutcomeToProto(duel *models.Duel, userID int64) desc.Outcome {
+	switch duel.WinnerID {
+	case 0:
+		return desc.Outcome_OUTCOME_DRAW
+	case userID:
+		return desc.Outcome_OUTCOME_WIN
+	default:
+		return desc.Outcome_OUTCOME_LOSS
+	}
+}
@@ -0,0 +1,92 @@
+package duel
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	duel_models "github.com/casnerano/snippet-war/internal/model/duel"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	duel_service "github.com/casnerano/snippet-war/internal/service/duel"
+	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/duel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type duelService interface {
+	Play(ctx context.Context, args duel_service.PlayArgs, send func(event *duel_models.Event) error) error
+	SubmitAnswer(ctx context.Context, duelID string, args quiz_service.SubmitAnswerArgs) (*quiz_models.AnswerResult, *duel_models.Answer, error)
+}
+
+type Arena struct {
+	desc.UnimplementedArenaServer
+
+	duelService duelService
+}
+
+func NewArena(duelService duelService) *Arena {
+	return &Arena{
+		duelService: duelService,
+	}
+}
+
+func (a *Arena) Duel(request *desc.Duel_Request, stream desc.Arena_DuelServer) error {
+	ctx := stream.Context()
+
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return toStatusError(ctx, "failed play duel", err)
+	}
+
+	err = a.duelService.Play(ctx, duel_service.PlayArgs{
+		Language:   quiz_handler.ProtoToLanguage(request.GetLanguage()),
+		Difficulty: quiz_handler.ProtoToDifficulty(request.GetDifficulty()),
+	}, func(event *duel_models.Event) error {
+		return stream.Send(EventToProto(event, user.ID))
+	})
+	if err != nil {
+		return toStatusError(ctx, "failed play duel", err)
+	}
+
+	return nil
+}
+
+func (a *Arena) SubmitAnswer(ctx context.Context, request *desc.SubmitAnswer_Request) (*desc.SubmitAnswer_Response, error) {
+	result, answer, err := a.duelService.SubmitAnswer(ctx, request.DuelId, quiz_service.SubmitAnswerArgs{
+		QuestionID: request.GetAnswer().GetQuestionId(),
+		Answer:     quiz_handler.ProtoToUserAnswer(request.GetAnswer()),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, "failed submit duel answer", err)
+	}
+
+	return &desc.SubmitAnswer_Response{
+		Result:  quiz_handler.AnswerResultToProto(result),
+		Elapsed: durationpb.New(answer.Elapsed),
+	}, nil
+}
+
+func toStatusError(ctx context.Context, msg string, err error) error {
+	statusCode, logLevel := quiz_handler.ErrorToStatus(err)
+
+	switch {
+	case errors.Is(err, duel_models.ErrDuelNotFound):
+		statusCode, logLevel = codes.NotFound, slog.LevelInfo
+	case errors.Is(err, duel_models.ErrNoTopics):
+		statusCode, logLevel = codes.InvalidArgument, slog.LevelInfo
+	case errors.Is(err, duel_models.ErrAlreadyQueued):
+		statusCode, logLevel = codes.AlreadyExists, slog.LevelInfo
+	case errors.Is(err, duel_models.ErrDuelFinished),
+		errors.Is(err, duel_models.ErrQuestionNotCurrent),
+		errors.Is(err, duel_models.ErrAlreadyAnswered):
+		statusCode, logLevel = codes.FailedPrecondition, slog.LevelInfo
+	}
+
+	slog.Log(ctx, logLevel, msg, "error", err, "code", statusCode.String())
+
+	return status.Error(statusCode, statusCode.String())
+}
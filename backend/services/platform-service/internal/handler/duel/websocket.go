@@ -0,0 +1,197 @@
+package duel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/casnerano/snippet-war/internal/requestid"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/duel"
+	quiz_desc "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+	"github.com/coder/websocket"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	marshalOptions   = protojson.MarshalOptions{EmitUnpopulated: true}
+	unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+type WebSocketOptions struct {
+	// AllowedOrigins lists origins allowed to connect from other hosts, "*"
+	// allows any.
+	AllowedOrigins []string
+}
+
+// WebSocket bridges the Arena service for the Mini App, browsers can not
+// consume gRPC streams. The duel is requested with "language" and
+// "difficulty" query parameters and authenticated with the Authorization
+// header or the "init_data" query parameter, as browsers can not set headers
+// on WebSocket requests.
+//
+// Every server message is a JSON object with one of "event" (Duel.Event),
+// "answer" (SubmitAnswer.Response) or "error" fields. Client messages are
+// SubmitAnswer.Request in JSON. Calls go through the gRPC server, so they are
+// authenticated, rate limited and validated like any other request.
+type WebSocket struct {
+	client  desc.ArenaClient
+	options atomic.Pointer[WebSocketOptions]
+}
+
+func NewWebSocket(client desc.ArenaClient, options WebSocketOptions) *WebSocket {
+	w := &WebSocket{
+		client: client,
+	}
+	w.SetOptions(options)
+
+	return w
+}
+
+func (w *WebSocket) SetOptions(options WebSocketOptions) {
+	w.options.Store(&options)
+}
+
+type frameError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (w *WebSocket) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	request := &desc.Duel_Request{
+		Language:   quiz_desc.Language(quiz_desc.Language_value[query.Get("language")]),
+		Difficulty: quiz_desc.Difficulty(quiz_desc.Difficulty_value[query.Get("difficulty")]),
+	}
+
+	conn, err := websocket.Accept(rw, r, &websocket.AcceptOptions{
+		OriginPatterns: w.options.Load().AllowedOrigins,
+	})
+	if err != nil {
+		slog.InfoContext(r.Context(), "failed accept websocket", "error", err)
+		return
+	}
+	defer conn.CloseNow()
+
+	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(r.Context(), outgoingMetadata(r)))
+	defer cancel()
+
+	stream, err := w.client.Duel(ctx, request)
+	if err != nil {
+		closeWithError(ctx, conn, err)
+		return
+	}
+
+	go func() {
+		defer cancel()
+		w.readAnswers(ctx, conn)
+	}()
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			_ = conn.Close(websocket.StatusNormalClosure, "duel finished")
+			return
+		}
+		if err != nil {
+			closeWithError(ctx, conn, err)
+			return
+		}
+
+		if err = writeMessage(ctx, conn, "event", event); err != nil {
+			return
+		}
+	}
+}
+
+func (w *WebSocket) readAnswers(ctx context.Context, conn *websocket.Conn) {
+	for {
+		_, data, err := conn.Read(ctx)
+		if err != nil {
+			return
+		}
+
+		request := &desc.SubmitAnswer_Request{}
+		if err = unmarshalOptions.Unmarshal(data, request); err != nil {
+			_ = writeError(ctx, conn, "INVALID_ARGUMENT", err.Error())
+			continue
+		}
+
+		response, err := w.client.SubmitAnswer(ctx, request)
+		if err != nil {
+			st := status.Convert(err)
+			_ = writeError(ctx, conn, st.Code().String(), st.Message())
+			continue
+		}
+
+		_ = writeMessage(ctx, conn, "answer", response)
+	}
+}
+
+func writeMessage(ctx context.Context, conn *websocket.Conn, key string, message proto.Message) error {
+	data, err := marshalOptions.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(ctx, conn, map[string]json.RawMessage{key: data})
+}
+
+func writeError(ctx context.Context, conn *websocket.Conn, code, message string) error {
+	return writeJSON(ctx, conn, map[string]frameError{
+		"error": {
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+func writeJSON(ctx context.Context, conn *websocket.Conn, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return conn.Write(ctx, websocket.MessageText, data)
+}
+
+func closeWithError(ctx context.Context, conn *websocket.Conn, err error) {
+	st := status.Convert(err)
+
+	_ = writeError(ctx, conn, st.Code().String(), st.Message())
+	_ = conn.Close(websocket.StatusPolicyViolation, st.Code().String())
+}
+
+// outgoingMetadata passes credentials, the request ID and the client address
+// to the gRPC server the way the gateway does.
+func outgoingMetadata(r *http.Request) metadata.MD {
+	md := metadata.MD{}
+
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		md.Set("authorization", authorization)
+	} else if initData := r.URL.Query().Get("init_data"); initData != "" {
+		md.Set("authorization", "tma "+initData)
+	}
+
+	if id := requestid.FromContext(r.Context()); id != "" {
+		md.Set(requestid.Header, id)
+	}
+
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		forwardedFor := host
+		if prior := r.Header.Get("X-Forwarded-For"); prior != "" {
+			forwardedFor = prior + ", " + host
+		}
+		md.Set("x-forwarded-for", forwardedFor)
+	}
+
+	return md
+}
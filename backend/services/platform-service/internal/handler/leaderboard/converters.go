@@ -0,0 +1,45 @@
+package leaderboard
+
+import (
+	models "github.com/casnerano/snippet-war/internal/model/leaderboard"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/leaderboard"
+)
+
+func EntryToProto(entry *models.Entry) *desc.Entry {
+	if entry == nil {
+		return nil
+	}
+
+	return &desc.Entry{
+		Rank:        entry.Rank,
+		UserId:      entry.UserID,
+		DisplayName: entry.DisplayName,
+		Score:       entry.Score,
+	}
+}
+
+func EntriesToProto(entries []*models.Entry) []*desc.Entry {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	pbEntries := make([]*desc.Entry, 0, len(entries))
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, EntryToProto(entry))
+	}
+
+	return pbEntries
+}
+
+func ProtoToPeriod(period desc.Period) models.Period {
+	switch period {
+	case desc.Period_PERIOD_ALL_TIME:
+		return models.PeriodAllTime
+	case desc.Period_PERIOD_WEEKLY:
+		return models.PeriodWeekly
+	case desc.Period_PERIOD_DAILY:
+		return models.PeriodDaily
+	default:
+		return models.PeriodUnspecified
+	}
+}
@@ -0,0 +1,49 @@
+package leaderboard
+
+import (
+	"context"
+	"log/slog"
+
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	leaderboard_models "github.com/casnerano/snippet-war/internal/model/leaderboard"
+	leaderboard_service "github.com/casnerano/snippet-war/internal/service/leaderboard"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/leaderboard"
+	"google.golang.org/grpc/status"
+)
+
+type leaderboardService interface {
+	GetLeaderboard(ctx context.Context, args leaderboard_service.GetLeaderboardArgs) (*leaderboard_models.Leaderboard, error)
+}
+
+type Leaderboard struct {
+	desc.UnimplementedLeaderboardServer
+
+	leaderboardService leaderboardService
+}
+
+func NewLeaderboard(leaderboardService leaderboardService) *Leaderboard {
+	return &Leaderboard{
+		leaderboardService: leaderboardService,
+	}
+}
+
+func (l *Leaderboard) GetLeaderboard(ctx context.Context, request *desc.GetLeaderboard_Request) (*desc.GetLeaderboard_Response, error) {
+	leaderboard, err := l.leaderboardService.GetLeaderboard(ctx, leaderboard_service.GetLeaderboardArgs{
+		Period:     ProtoToPeriod(request.Period),
+		Language:   quiz_handler.ProtoToLanguage(request.Language),
+		Difficulty: quiz_handler.ProtoToDifficulty(request.Difficulty),
+		Limit:      request.Limit,
+	})
+	if err != nil {
+		statusCode, logLevel := quiz_handler.ErrorToStatus(err)
+
+		slog.Log(ctx, logLevel, "failed get leaderboard", "error", err, "code", statusCode.String())
+
+		return nil, status.Error(statusCode, statusCode.String())
+	}
+
+	return &desc.GetLeaderboard_Response{
+		Entries: EntriesToProto(leaderboard.Entries),
+		Me:      EntryToProto(leaderboard.Me),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package profile
+
+import (
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	models "github.com/casnerano/snippet-war/internal/model/profile"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/profile"
+)
+
+func ProfileToProto(profile *models.Profile) *desc.Profile {
+	if profile == nil {
+		return nil
+	}
+
+	return &desc.Profile{
+		UserId:      profile.UserID,
+		DisplayName: profile.DisplayName,
+		Xp:          profile.XP,
+		Level:       profile.Level,
+		LevelXp:     profile.LevelXP,
+		NextLevelXp: profile.NextLevelXP,
+		Answered:    profile.Answered,
+		Correct:     profile.Correct,
+		Accuracy:    profile.Accuracy,
+		Mastery:     MasteryToProto(profile.Mastery),
+	}
+}
+
+func MasteryToProto(mastery []*models.Mastery) []*desc.Mastery {
+	if len(mastery) == 0 {
+		return nil
+	}
+
+	pbMastery := make([]*desc.Mastery, 0, len(mastery))
+	for _, m := range mastery {
+		pbMastery = append(pbMastery, &desc.Mastery{
+			Language: quiz_handler.LanguageToProto(m.Language),
+			Topic:    m.Topic,
+			Answered: m.Answered,
+			Correct:  m.Correct,
+			Score:    m.Score,
+		})
+	}
+
+	return pbMastery
+}
@@ -0,0 +1,42 @@
+package profile
+
+import (
+	"context"
+	"log/slog"
+
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	profile_models "github.com/casnerano/snippet-war/internal/model/profile"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/profile"
+	"google.golang.org/grpc/status"
+)
+
+type profileService interface {
+	GetProfile(ctx context.Context) (*profile_models.Profile, error)
+}
+
+type Profiles struct {
+	desc.UnimplementedProfilesServer
+
+	profileService profileService
+}
+
+func NewProfiles(profileService profileService) *Profiles {
+	return &Profiles{
+		profileService: profileService,
+	}
+}
+
+func (p *Profiles) GetProfile(ctx context.Context, _ *desc.GetProfile_Request) (*desc.GetProfile_Response, error) {
+	profile, err := p.profileService.GetProfile(ctx)
+	if err != nil {
+		statusCode, logLevel := quiz_handler.ErrorToStatus(err)
+
+		slog.Log(ctx, logLevel, "failed get profile", "error", err, "code", statusCode.String())
+
+		return nil, status.Error(statusCode, statusCode.String())
+	}
+
+	return &desc.GetProfile_Response{
+		Profile: ProfileToProto(profile),
+	}, nil
+}
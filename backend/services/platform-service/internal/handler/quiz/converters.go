@@ -1,8 +1,10 @@
 package quiz
 
 import (
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
 	models "github.com/casnerano/snippet-war/internal/model/quiz"
 	desc "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func QuestionToProto(question *models.Question) *desc.Question {
@@ -11,30 +13,30 @@ func QuestionToProto(question *models.Question) *desc.Question {
 	}
 
 	pb := &desc.Question{
-		Id:          question.ID,
-		Language:    LanguageToProto(question.Language),
-		Topic:       question.Topic,
-		Difficulty:  DifficultyToProto(question.Difficulty),
-		Explanation: question.Explanation,
+		Id:         question.ID,
+		Language:   LanguageToProto(question.Language),
+		Topic:      question.Topic,
+		Difficulty: DifficultyToProto(question.Difficulty),
 		Content: &desc.Question_Content{
 			Text: question.Content.Text,
 			Code: question.Content.Code,
 		},
 	}
 
+	if !question.Deadline.IsZero() {
+		pb.Deadline = timestamppb.New(question.Deadline)
+	}
+
 	switch answer := question.Answer.(type) {
 	case *models.MultipleChoiceAnswer:
 		pb.Answer = &desc.Question_MultipleChoice{
 			MultipleChoice: &desc.Question_MultipleChoiceAnswer{
-				Options:        answer.Options,
-				CorrectOptions: answer.CorrectOptions,
+				Options: answer.Options,
 			},
 		}
 	case *models.FreeTextAnswer:
 		pb.Answer = &desc.Question_FreeText{
-			FreeText: &desc.Question_FreeTextAnswer{
-				CorrectAnswers: answer.CorrectAnswers,
-			},
+			FreeText: &desc.Question_FreeTextAnswer{},
 		}
 	}
 
@@ -54,6 +56,36 @@ func QuestionsToProto(questions []*models.Question) []*desc.Question {
 	return pbQuestions
 }
 
+func ProtoToUserAnswer(request *desc.SubmitAnswer_Request) models.UserAnswer {
+	switch answer := request.Answer.(type) {
+	case *desc.SubmitAnswer_Request_MultipleChoice:
+		return &models.MultipleChoiceUserAnswer{
+			SelectedOptions: answer.MultipleChoice.GetSelectedOptions(),
+		}
+	case *desc.SubmitAnswer_Request_FreeText:
+		return &models.FreeTextUserAnswer{
+			Text: answer.FreeText.GetText(),
+		}
+	default:
+		return nil
+	}
+}
+
+func AnswerResultToProto(result *models.AnswerResult) *desc.SubmitAnswer_Response {
+	if result == nil {
+		return nil
+	}
+
+	return &desc.SubmitAnswer_Response{
+		Correct:        result.Correct,
+		CorrectAnswers: result.CorrectAnswers,
+		Explanation:    result.Explanation,
+		Points:         result.Points,
+		Late:           result.Late,
+		SpeedBonus:     result.SpeedBonus,
+	}
+}
+
 func ProtoToLanguage(language desc.Language) models.Language {
 	switch language {
 	case desc.Language_LANGUAGE_PYTHON:
@@ -88,6 +120,32 @@ func ProtoToDifficulty(difficulty desc.Difficulty) models.Difficulty {
 	}
 }
 
+func ProtoToAnswerType(answerType desc.AnswerType) models.AnswerType {
+	switch answerType {
+	case desc.AnswerType_ANSWER_TYPE_MULTIPLE_CHOICE:
+		return models.AnswerTypeMultipleChoice
+	case desc.AnswerType_ANSWER_TYPE_FREE_TEXT:
+		return models.AnswerTypeFreeText
+	case desc.AnswerType_ANSWER_TYPE_MIXED:
+		return models.AnswerTypeMixed
+	default:
+		return models.AnswerTypeUnspecified
+	}
+}
+
+func AnswerTypeToProto(answerType models.AnswerType) desc.AnswerType {
+	switch answerType {
+	case models.AnswerTypeMultipleChoice:
+		return desc.AnswerType_ANSWER_TYPE_MULTIPLE_CHOICE
+	case models.AnswerTypeFreeText:
+		return desc.AnswerType_ANSWER_TYPE_FREE_TEXT
+	case models.AnswerTypeMixed:
+		return desc.AnswerType_ANSWER_TYPE_MIXED
+	default:
+		return desc.AnswerType_ANSWER_TYPE_UNSPECIFIED
+	}
+}
+
 func LanguageToProto(language models.Language) desc.Language {
 	switch language {
 	case models.LanguagePython:
@@ -121,3 +179,50 @@ func DifficultyToProto(difficulty models.Difficulty) desc.Difficulty {
 		return desc.Difficulty_DIFFICULTY_UNSPECIFIED
 	}
 }
+
+func DifficultiesToProto(difficulties []models.Difficulty) []desc.Difficulty {
+	if len(difficulties) == 0 {
+		return nil
+	}
+
+	pbDifficulties := make([]desc.Difficulty, 0, len(difficulties))
+	for _, d := range difficulties {
+		pbDifficulties = append(pbDifficulties, DifficultyToProto(d))
+	}
+
+	return pbDifficulties
+}
+
+func LanguagesToProto(languages []*catalog_models.Language, locale, defaultLocale string) []*desc.LanguageInfo {
+	if len(languages) == 0 {
+		return nil
+	}
+
+	pbLanguages := make([]*desc.LanguageInfo, 0, len(languages))
+	for _, l := range languages {
+		pbLanguages = append(pbLanguages, &desc.LanguageInfo{
+			Language:     LanguageToProto(l.ID),
+			DisplayName:  l.Names.Get(locale, defaultLocale),
+			Difficulties: DifficultiesToProto(l.Difficulties()),
+		})
+	}
+
+	return pbLanguages
+}
+
+func TopicsToProto(topics []*catalog_models.Topic, locale, defaultLocale string) []*desc.Topic {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	pbTopics := make([]*desc.Topic, 0, len(topics))
+	for _, t := range topics {
+		pbTopics = append(pbTopics, &desc.Topic{
+			Id:           t.ID,
+			DisplayName:  t.Names.Get(locale, defaultLocale),
+			Difficulties: DifficultiesToProto(t.Difficulties),
+		})
+	}
+
+	return pbTopics
+}
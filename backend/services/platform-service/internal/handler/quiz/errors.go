@@ -0,0 +1,53 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/client/content_service"
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ErrorToStatus(err error) (codes.Code, slog.Level) {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return codes.Unauthenticated, slog.LevelInfo
+	case errors.Is(err, quiz_models.ErrQuestionNotFound):
+		return codes.NotFound, slog.LevelInfo
+	case errors.Is(err, quiz_models.ErrAlreadyAnswered):
+		return codes.FailedPrecondition, slog.LevelInfo
+	case errors.Is(err, quiz_models.ErrAnswerTypeMismatch),
+		errors.Is(err, catalog_models.ErrUnknownLanguage),
+		errors.Is(err, catalog_models.ErrUnknownTopic),
+		errors.Is(err, catalog_models.ErrTopicDifficulty):
+		return codes.InvalidArgument, slog.LevelInfo
+	case errors.Is(err, content_service.ErrValidation):
+		return codes.InvalidArgument, slog.LevelWarn
+	case errors.Is(err, content_service.ErrNotFound):
+		return codes.NotFound, slog.LevelWarn
+	case errors.Is(err, content_service.ErrRateLimited):
+		return codes.ResourceExhausted, slog.LevelWarn
+	case errors.Is(err, content_service.ErrTimeout),
+		errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded, slog.LevelWarn
+	case errors.Is(err, content_service.ErrUnavailable):
+		return codes.Unavailable, slog.LevelError
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled, slog.LevelInfo
+	default:
+		return codes.Internal, slog.LevelError
+	}
+}
+
+func toStatusError(ctx context.Context, msg string, err error) error {
+	statusCode, logLevel := ErrorToStatus(err)
+
+	slog.Log(ctx, logLevel, msg, "error", err, "code", statusCode.String())
+
+	return status.Error(statusCode, statusCode.String())
+}
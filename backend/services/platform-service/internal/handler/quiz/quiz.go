@@ -2,28 +2,35 @@ package quiz
 
 import (
 	"context"
-	"log/slog"
 
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
 	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
 	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
 	desc "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type quizService interface {
 	GetQuestions(ctx context.Context, args quiz_service.GetQuestionsArgs) ([]*quiz_models.Question, error)
+	SubmitAnswer(ctx context.Context, args quiz_service.SubmitAnswerArgs) (*quiz_models.AnswerResult, error)
+}
+
+type catalogService interface {
+	DefaultLocale() string
+	ListLanguages(ctx context.Context) ([]*catalog_models.Language, error)
+	ListTopics(ctx context.Context, language quiz_models.Language) ([]*catalog_models.Topic, error)
 }
 
 type Quiz struct {
 	desc.UnimplementedQuizServer
 
-	quizService quizService
+	quizService    quizService
+	catalogService catalogService
 }
 
-func NewQuiz(quizService quizService) *Quiz {
+func NewQuiz(quizService quizService, catalogService catalogService) *Quiz {
 	return &Quiz{
-		quizService: quizService,
+		quizService:    quizService,
+		catalogService: catalogService,
 	}
 }
 
@@ -33,16 +40,11 @@ func (q *Quiz) ListQuestions(ctx context.Context, request *desc.ListQuestions_Re
 		Topics:     request.Topics,
 		Difficulty: ProtoToDifficulty(request.Difficulty),
 		Limit:      request.Limit,
+		AnswerType: ProtoToAnswerType(request.AnswerType),
+		Mode:       quiz_models.ModeQuiz,
 	})
 	if err != nil {
-		var (
-			statusCode = codes.Internal
-			logLevel   = slog.LevelError
-		)
-
-		slog.Log(ctx, logLevel, "failed get questions", "error", err)
-
-		return nil, status.Error(statusCode, statusCode.String())
+		return nil, toStatusError(ctx, "failed get questions", err)
 	}
 
 	response := desc.ListQuestions_Response{
@@ -51,3 +53,42 @@ func (q *Quiz) ListQuestions(ctx context.Context, request *desc.ListQuestions_Re
 
 	return &response, nil
 }
+
+func (q *Quiz) SubmitAnswer(ctx context.Context, request *desc.SubmitAnswer_Request) (*desc.SubmitAnswer_Response, error) {
+	result, err := q.quizService.SubmitAnswer(ctx, quiz_service.SubmitAnswerArgs{
+		QuestionID: request.QuestionId,
+		Answer:     ProtoToUserAnswer(request),
+		Mode:       quiz_models.ModeQuiz,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, "failed submit answer", err)
+	}
+
+	return AnswerResultToProto(result), nil
+}
+
+func (q *Quiz) ListLanguages(ctx context.Context, request *desc.ListLanguages_Request) (*desc.ListLanguages_Response, error) {
+	languages, err := q.catalogService.ListLanguages(ctx)
+	if err != nil {
+		return nil, toStatusError(ctx, "failed list languages", err)
+	}
+
+	response := desc.ListLanguages_Response{
+		Languages: LanguagesToProto(languages, request.Locale, q.catalogService.DefaultLocale()),
+	}
+
+	return &response, nil
+}
+
+func (q *Quiz) ListTopics(ctx context.Context, request *desc.ListTopics_Request) (*desc.ListTopics_Response, error) {
+	topics, err := q.catalogService.ListTopics(ctx, ProtoToLanguage(request.Language))
+	if err != nil {
+		return nil, toStatusError(ctx, "failed list topics", err)
+	}
+
+	response := desc.ListTopics_Response{
+		Topics: TopicsToProto(topics, request.Locale, q.catalogService.DefaultLocale()),
+	}
+
+	return &response, nil
+}
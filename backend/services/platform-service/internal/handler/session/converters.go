@@ -0,0 +1,45 @@
+package session
+
+import (
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	models "github.com/casnerano/snippet-war/internal/model/session"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/session"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func SessionToProto(session *models.Session) *desc.Session {
+	if session == nil {
+		return nil
+	}
+
+	pb := &desc.Session{
+		Id:                session.ID,
+		Status:            StatusToProto(session.Status),
+		Language:          quiz_handler.LanguageToProto(session.Language),
+		Topics:            session.Topics,
+		Difficulty:        quiz_handler.DifficultyToProto(session.Difficulty),
+		AnswerType:        quiz_handler.AnswerTypeToProto(session.AnswerType),
+		TotalQuestions:    session.TotalQuestions,
+		AnsweredQuestions: session.AnsweredQuestions,
+		CorrectAnswers:    session.CorrectAnswers,
+		Score:             session.Score,
+		StartedAt:         timestamppb.New(session.StartedAt),
+	}
+
+	if session.FinishedAt != nil {
+		pb.FinishedAt = timestamppb.New(*session.FinishedAt)
+	}
+
+	return pb
+}
+
+func StatusToProto(status models.Status) desc.Session_Status {
+	switch status {
+	case models.StatusActive:
+		return desc.Session_STATUS_ACTIVE
+	case models.StatusFinished:
+		return desc.Session_STATUS_FINISHED
+	default:
+		return desc.Session_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,110 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	quiz_handler "github.com/casnerano/snippet-war/internal/handler/quiz"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	session_models "github.com/casnerano/snippet-war/internal/model/session"
+	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+	session_service "github.com/casnerano/snippet-war/internal/service/session"
+	desc "github.com/casnerano/snippet-war/pkg/api/v1/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type sessionService interface {
+	StartSession(ctx context.Context, args session_service.StartSessionArgs) (*session_models.Session, error)
+	NextQuestion(ctx context.Context, sessionID string) (*session_models.Session, *quiz_models.Question, error)
+	SubmitAnswer(ctx context.Context, sessionID string, args quiz_service.SubmitAnswerArgs) (*session_models.Session, *quiz_models.AnswerResult, error)
+	FinishSession(ctx context.Context, sessionID string) (*session_models.Session, error)
+}
+
+type GameSession struct {
+	desc.UnimplementedGameSessionServer
+
+	sessionService sessionService
+}
+
+func NewGameSession(sessionService sessionService) *GameSession {
+	return &GameSession{
+		sessionService: sessionService,
+	}
+}
+
+func (g *GameSession) StartSession(ctx context.Context, request *desc.StartSession_Request) (*desc.StartSession_Response, error) {
+	questions := request.GetQuestions()
+
+	session, err := g.sessionService.StartSession(ctx, session_service.StartSessionArgs{
+		Language:       quiz_handler.ProtoToLanguage(questions.GetLanguage()),
+		Topics:         questions.GetTopics(),
+		Difficulty:     quiz_handler.ProtoToDifficulty(questions.GetDifficulty()),
+		AnswerType:     quiz_handler.ProtoToAnswerType(questions.GetAnswerType()),
+		TotalQuestions: questions.GetLimit(),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, "failed start session", err)
+	}
+
+	return &desc.StartSession_Response{
+		Session: SessionToProto(session),
+	}, nil
+}
+
+func (g *GameSession) NextQuestion(ctx context.Context, request *desc.NextQuestion_Request) (*desc.NextQuestion_Response, error) {
+	session, question, err := g.sessionService.NextQuestion(ctx, request.SessionId)
+	if err != nil {
+		return nil, toStatusError(ctx, "failed get next question", err)
+	}
+
+	return &desc.NextQuestion_Response{
+		Session:  SessionToProto(session),
+		Question: quiz_handler.QuestionToProto(question),
+	}, nil
+}
+
+func (g *GameSession) SubmitAnswer(ctx context.Context, request *desc.SubmitAnswer_Request) (*desc.SubmitAnswer_Response, error) {
+	session, result, err := g.sessionService.SubmitAnswer(ctx, request.SessionId, quiz_service.SubmitAnswerArgs{
+		QuestionID: request.GetAnswer().GetQuestionId(),
+		Answer:     quiz_handler.ProtoToUserAnswer(request.GetAnswer()),
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, "failed submit session answer", err)
+	}
+
+	return &desc.SubmitAnswer_Response{
+		Session: SessionToProto(session),
+		Result:  quiz_handler.AnswerResultToProto(result),
+	}, nil
+}
+
+func (g *GameSession) FinishSession(ctx context.Context, request *desc.FinishSession_Request) (*desc.FinishSession_Response, error) {
+	session, err := g.sessionService.FinishSession(ctx, request.SessionId)
+	if err != nil {
+		return nil, toStatusError(ctx, "failed finish session", err)
+	}
+
+	return &desc.FinishSession_Response{
+		Session: SessionToProto(session),
+	}, nil
+}
+
+func toStatusError(ctx context.Context, msg string, err error) error {
+	statusCode, logLevel := quiz_handler.ErrorToStatus(err)
+
+	switch {
+	case errors.Is(err, session_models.ErrSessionNotFound):
+		statusCode, logLevel = codes.NotFound, slog.LevelInfo
+	case errors.Is(err, session_models.ErrSessionFinished),
+		errors.Is(err, session_models.ErrNoQuestionsLeft),
+		errors.Is(err, session_models.ErrAnswerPending),
+		errors.Is(err, session_models.ErrQuestionNotCurrent):
+		statusCode, logLevel = codes.FailedPrecondition, slog.LevelInfo
+	}
+
+	slog.Log(ctx, logLevel, msg, "error", err, "code", statusCode.String())
+
+	return status.Error(statusCode, statusCode.String())
+}
@@ -0,0 +1,230 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	grpc_health "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	statusOK           = "ok"
+	statusDegraded     = "degraded"
+	statusUnavailable  = "unavailable"
+	statusShuttingDown = "shutting down"
+)
+
+type checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc adapts a function to a readiness checker.
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+type Options struct {
+	// Interval between readiness checks reported through grpc.health.v1.
+	Interval time.Duration
+	// Timeout limits every readiness check.
+	Timeout time.Duration
+}
+
+type registration struct {
+	checker checker
+	// fallback serves instead of the checked dependency, while it passes a
+	// failed check only degrades the service.
+	fallback checker
+}
+
+type readiness struct {
+	checks map[string]string
+	ready  bool
+}
+
+// Health tracks liveness and readiness of the service and reports it both
+// through the grpc.health.v1 service and /healthz, /readyz HTTP handlers.
+// Checks run in Run, the handlers serve the last result.
+type Health struct {
+	options   Options
+	server    *grpc_health.Server
+	checkers  map[string]registration
+	readiness atomic.Pointer[readiness]
+	shutdown  atomic.Bool
+}
+
+func New(options Options) *Health {
+	if options.Interval <= 0 {
+		options.Interval = 10 * time.Second
+	}
+
+	server := grpc_health.NewServer()
+	server.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	return &Health{
+		options:  options,
+		server:   server,
+		checkers: make(map[string]registration),
+	}
+}
+
+// Register adds a readiness checker. It must be called before Run.
+func (h *Health) Register(name string, checker checker) {
+	h.checkers[name] = registration{checker: checker}
+}
+
+// RegisterWithFallback adds a readiness checker for a dependency that
+// fallback can replace. Its failure reports the check as degraded and keeps
+// the service ready as long as fallback passes. It must be called before Run.
+func (h *Health) RegisterWithFallback(name string, checker, fallback checker) {
+	h.checkers[name] = registration{checker: checker, fallback: fallback}
+}
+
+func (h *Health) Server() grpc_health_v1.HealthServer {
+	return h.server
+}
+
+// Run keeps the gRPC serving status in sync with readiness checks until ctx
+// is done.
+func (h *Health) Run(ctx context.Context) error {
+	ticker := time.NewTicker(h.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		h.update(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks the service as not ready, so load balancers stop routing
+// new requests while in-flight ones are drained.
+func (h *Health) Shutdown() {
+	h.shutdown.Store(true)
+	h.server.Shutdown()
+}
+
+func (h *Health) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeStatus(w, http.StatusOK, statusOK, nil)
+	})
+}
+
+func (h *Health) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.shutdown.Load() {
+			writeStatus(w, http.StatusServiceUnavailable, statusShuttingDown, nil)
+			return
+		}
+
+		// Nothing is cached until the first check of Run.
+		status := h.readiness.Load()
+		if status == nil || !status.ready {
+			var checks map[string]string
+			if status != nil {
+				checks = status.checks
+			}
+
+			writeStatus(w, http.StatusServiceUnavailable, statusUnavailable, checks)
+			return
+		}
+
+		writeStatus(w, http.StatusOK, statusOK, status.checks)
+	})
+}
+
+func (h *Health) update(ctx context.Context) {
+	checks, ready := h.check(ctx)
+	h.readiness.Store(&readiness{checks: checks, ready: ready})
+
+	if h.shutdown.Load() {
+		return
+	}
+
+	servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+	if !ready {
+		servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	h.server.SetServingStatus("", servingStatus)
+}
+
+func (h *Health) check(ctx context.Context) (map[string]string, bool) {
+	if h.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.options.Timeout)
+		defer cancel()
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		checks = make(map[string]string, len(h.checkers))
+		ready  = true
+	)
+
+	for name, registration := range h.checkers {
+		wg.Go(func() {
+			status := registration.check(ctx, name)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			checks[name] = status
+			if status == statusUnavailable {
+				ready = false
+			}
+		})
+	}
+
+	wg.Wait()
+
+	return checks, ready
+}
+
+func (r registration) check(ctx context.Context, name string) string {
+	err := r.checker.Check(ctx)
+	if err == nil {
+		return statusOK
+	}
+
+	if r.fallback == nil {
+		slog.WarnContext(ctx, "readiness check failed", "check", name, "error", err)
+		return statusUnavailable
+	}
+
+	if fallbackErr := r.fallback.Check(ctx); fallbackErr != nil {
+		slog.WarnContext(ctx, "readiness check and fallback failed", "check", name, "error", err, "fallback_error", fallbackErr)
+		return statusUnavailable
+	}
+
+	slog.WarnContext(ctx, "readiness check failed, serving from fallback", "check", name, "error", err)
+
+	return statusDegraded
+}
+
+func writeStatus(w http.ResponseWriter, code int, status string, checks map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(struct {
+		Status string            `json:"status"`
+		Checks map[string]string `json:"checks,omitempty"`
+	}{
+		Status: status,
+		Checks: checks,
+	})
+}
@@ -0,0 +1,93 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+type countingChecker struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (c *countingChecker) Check(_ context.Context) error {
+	c.calls.Add(1)
+	return c.err
+}
+
+type readinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func getReadiness(t *testing.T, h *Health) (int, readinessResponse) {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	h.ReadinessHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var response readinessResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("decode readiness: %v", err)
+	}
+
+	return recorder.Code, response
+}
+
+func TestReadinessServesCachedStatus(t *testing.T) {
+	h := New(Options{})
+	checker := &countingChecker{}
+	h.Register("postgres", checker)
+
+	if code, _ := getReadiness(t, h); code != http.StatusServiceUnavailable {
+		t.Errorf("got %d before the first check, want 503", code)
+	}
+
+	h.update(context.Background())
+
+	for range 3 {
+		if code, response := getReadiness(t, h); code != http.StatusOK || response.Checks["postgres"] != statusOK {
+			t.Errorf("got %d %+v, want 200 with postgres ok", code, response)
+		}
+	}
+
+	if got := checker.calls.Load(); got != 1 {
+		t.Errorf("got %d checks, want 1", got)
+	}
+
+	h.Shutdown()
+
+	if code, response := getReadiness(t, h); code != http.StatusServiceUnavailable || response.Status != statusShuttingDown {
+		t.Errorf("got %d %+v after shutdown, want 503 shutting down", code, response)
+	}
+}
+
+func TestReadinessWithFallback(t *testing.T) {
+	down := errors.New("connection refused")
+
+	for _, tc := range []struct {
+		name        string
+		fallbackErr error
+		wantCode    int
+		wantStatus  string
+	}{
+		{name: "fallback serves", wantCode: http.StatusOK, wantStatus: statusDegraded},
+		{name: "fallback fails", fallbackErr: errors.New("empty"), wantCode: http.StatusServiceUnavailable, wantStatus: statusUnavailable},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := New(Options{})
+			h.RegisterWithFallback("content_service", &countingChecker{err: down}, &countingChecker{err: tc.fallbackErr})
+			h.update(context.Background())
+
+			code, response := getReadiness(t, h)
+			if code != tc.wantCode || response.Checks["content_service"] != tc.wantStatus {
+				t.Errorf("got %d %+v, want %d with content_service %s", code, response, tc.wantCode, tc.wantStatus)
+			}
+		})
+	}
+}
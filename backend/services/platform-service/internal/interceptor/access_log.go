@@ -0,0 +1,42 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func AccessLog() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		slog.InfoContext(ctx, "grpc request",
+			"method", info.FullMethod,
+			"code", status.Code(err).String(),
+			"duration", time.Since(start),
+		)
+
+		return resp, err
+	}
+}
+
+func StreamAccessLog() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		slog.InfoContext(ss.Context(), "grpc stream",
+			"method", info.FullMethod,
+			"code", status.Code(err).String(),
+			"duration", time.Since(start),
+		)
+
+		return err
+	}
+}
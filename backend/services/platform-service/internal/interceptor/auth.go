@@ -0,0 +1,85 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	initDataScheme      = "tma "
+)
+
+type initDataValidator interface {
+	Validate(initData string) (*auth.User, error)
+}
+
+// Auth authenticates requests by Telegram Mini App initData passed as
+// "Authorization: tma <initData>". Methods listed in publicMethods are skipped.
+func Auth(validator initDataValidator, publicMethods ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, validator, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamAuth(validator initDataValidator, publicMethods ...string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), validator, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, validator initDataValidator, method string) (context.Context, error) {
+	initData, ok := initDataFromMetadata(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing telegram init data")
+	}
+
+	user, err := validator.Validate(initData)
+	if err != nil {
+		slog.InfoContext(ctx, "failed authenticate request", "method", method, "error", err)
+		return nil, status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
+	}
+
+	return auth.WithUser(ctx, user), nil
+}
+
+func initDataFromMetadata(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for _, value := range md.Get(authorizationHeader) {
+		if len(value) > len(initDataScheme) && strings.EqualFold(value[:len(initDataScheme)], initDataScheme) {
+			return value[len(initDataScheme):], true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,61 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const gatewayTokenHeader = "x-gateway-token"
+
+// GatewayCredentials mark calls of the HTTP gateway and the WebSocket bridge,
+// only they may pass the client address in "x-forwarded-for". The token is
+// random per process, so direct gRPC clients cannot forge it.
+type GatewayCredentials struct {
+	token string
+}
+
+func NewGatewayCredentials() (*GatewayCredentials, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, fmt.Errorf("failed to generate gateway token: %w", err)
+	}
+
+	return &GatewayCredentials{
+		token: hex.EncodeToString(token),
+	}, nil
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (c *GatewayCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{gatewayTokenHeader: c.token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials, the
+// gateway dials the server over loopback without TLS.
+func (c *GatewayCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func (c *GatewayCredentials) fromGateway(ctx context.Context) bool {
+	if c == nil {
+		return false
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	for _, token := range md.Get(gatewayTokenHeader) {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(c.token)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
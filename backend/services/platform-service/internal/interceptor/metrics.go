@@ -0,0 +1,33 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics records request count and latency per method and status code.
+func Metrics() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+		metrics.ObserveGRPCRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+		return resp, err
+	}
+}
+
+func StreamMetrics() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+		metrics.ObserveGRPCRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+		return err
+	}
+}
@@ -0,0 +1,110 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	forwardedForHeader = "x-forwarded-for"
+	retryAfterHeader   = "retry-after"
+)
+
+type rateLimiter interface {
+	Allow(method, user, ip string) (bool, time.Duration)
+}
+
+// RateLimit limits requests per authenticated user and per client IP. For
+// calls of the gateway, marked by gateway credentials, the IP is taken from
+// "x-forwarded-for" skipping trustedProxies addresses appended by proxies in
+// front of it. Direct gRPC clients are limited by the peer address.
+// Rejected requests get ResourceExhausted with "retry-after" in seconds.
+func RateLimit(limiter rateLimiter, gateway *GatewayCredentials, trustedProxies int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := allow(ctx, limiter, clientIP(ctx, gateway, trustedProxies), info.FullMethod, grpc.SetHeader); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRateLimit limits opening of streams the same way as RateLimit.
+func StreamRateLimit(limiter rateLimiter, gateway *GatewayCredentials, trustedProxies int) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		setHeader := func(_ context.Context, md metadata.MD) error {
+			return ss.SetHeader(md)
+		}
+
+		if err := allow(ss.Context(), limiter, clientIP(ss.Context(), gateway, trustedProxies), info.FullMethod, setHeader); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func allow(
+	ctx context.Context,
+	limiter rateLimiter,
+	ip string,
+	method string,
+	setHeader func(ctx context.Context, md metadata.MD) error,
+) error {
+	var userID string
+	if user, err := auth.UserFromContext(ctx); err == nil {
+		userID = user.TelegramID()
+	}
+
+	if ok, retryAfter := limiter.Allow(method, userID, ip); !ok {
+		seconds := strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+
+		_ = setHeader(ctx, metadata.Pairs(retryAfterHeader, seconds))
+		metrics.RateLimited(method)
+		slog.InfoContext(ctx, "rate limit exceeded", "method", method, "user_id", userID, "ip", ip)
+
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s seconds", seconds)
+	}
+
+	return nil
+}
+
+func clientIP(ctx context.Context, gateway *GatewayCredentials, trustedProxies int) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && gateway.fromGateway(ctx) {
+		var addrs []string
+		for _, value := range md.Get(forwardedForHeader) {
+			for addr := range strings.SplitSeq(value, ",") {
+				if addr = strings.TrimSpace(addr); addr != "" {
+					addrs = append(addrs, addr)
+				}
+			}
+		}
+
+		if len(addrs) > 0 {
+			return addrs[max(len(addrs)-1-trustedProxies, 0)]
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+
+	return ""
+}
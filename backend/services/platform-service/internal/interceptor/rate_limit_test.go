@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIP(t *testing.T) {
+	gateway, err := NewGatewayCredentials()
+	if err != nil {
+		t.Fatalf("new gateway credentials: %v", err)
+	}
+
+	token, err := gateway.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("get gateway metadata: %v", err)
+	}
+
+	peerCtx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50000},
+	})
+
+	for _, tc := range []struct {
+		name           string
+		md             metadata.MD
+		trustedProxies int
+		want           string
+	}{
+		{
+			name: "direct client",
+			md:   metadata.Pairs(forwardedForHeader, "203.0.113.7"),
+			want: "127.0.0.1",
+		},
+		{
+			name: "forged gateway token",
+			md:   metadata.Pairs(forwardedForHeader, "203.0.113.7", gatewayTokenHeader, "forged"),
+			want: "127.0.0.1",
+		},
+		{
+			name: "gateway",
+			md:   metadata.Pairs(forwardedForHeader, "203.0.113.7", gatewayTokenHeader, token[gatewayTokenHeader]),
+			want: "203.0.113.7",
+		},
+		{
+			name:           "gateway behind a proxy",
+			md:             metadata.Pairs(forwardedForHeader, "198.51.100.1, 203.0.113.7, 10.0.0.2", gatewayTokenHeader, token[gatewayTokenHeader]),
+			trustedProxies: 1,
+			want:           "203.0.113.7",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(peerCtx, tc.md)
+
+			if got := clientIP(ctx, gateway, tc.trustedProxies); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/casnerano/snippet-war/internal/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestID takes the request ID from "x-request-id" metadata or assigns a new
+// one, stores it in the context and returns it in the response header.
+func RequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		id := requestIDFromMetadata(ctx)
+		if id == "" {
+			id = requestid.New()
+		}
+
+		ctx = requestid.WithRequestID(ctx, id)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.Header, id))
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamRequestID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id := requestIDFromMetadata(ss.Context())
+		if id == "" {
+			id = requestid.New()
+		}
+
+		_ = ss.SetHeader(metadata.Pairs(requestid.Header, id))
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: requestid.WithRequestID(ss.Context(), id)})
+	}
+}
+
+func requestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, id := range md.Get(requestid.Header) {
+		if requestid.Valid(id) {
+			return id
+		}
+	}
+
+	return ""
+}
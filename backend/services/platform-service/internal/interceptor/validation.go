@@ -0,0 +1,139 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type allValidator interface {
+	ValidateAll() error
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// Validation runs the protoc-gen-validate rules of every request message and
+// rejects invalid requests with InvalidArgument and google.rpc.BadRequest details.
+func Validation() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if validator, ok := req.(allValidator); ok {
+			if err := validator.ValidateAll(); err != nil {
+				return nil, validationStatus(err).Err()
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidation validates every message received from the client.
+func StreamValidation() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if validator, ok := m.(allValidator); ok {
+		if err := validator.ValidateAll(); err != nil {
+			return validationStatus(err).Err()
+		}
+	}
+
+	return nil
+}
+
+func validationStatus(err error) *status.Status {
+	st := status.New(codes.InvalidArgument, "request validation failed")
+
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: fieldViolations("", err),
+	}
+
+	withDetails, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(prefix, e)...)
+		}
+		return violations
+	}
+
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return []*errdetails.BadRequest_FieldViolation{
+			{Field: prefix, Description: err.Error()},
+		}
+	}
+
+	field := joinField(prefix, toSnakeCase(fe.Field()))
+
+	if fe.Cause() != nil {
+		var (
+			nestedMulti multiError
+			nestedField fieldError
+		)
+		if errors.As(fe.Cause(), &nestedMulti) || errors.As(fe.Cause(), &nestedField) {
+			return fieldViolations(field, fe.Cause())
+		}
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{
+		{Field: field, Description: fe.Reason()},
+	}
+}
+
+func joinField(prefix, field string) string {
+	if prefix == "" {
+		return field
+	}
+
+	return prefix + "." + field
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+
+	for idx, r := range s {
+		if unicode.IsUpper(r) {
+			if idx > 0 && s[idx-1] != '[' && s[idx-1] != '.' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
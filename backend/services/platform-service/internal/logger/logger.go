@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/casnerano/snippet-war/internal/requestid"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+type Options struct {
+	Level  slog.Leveler
+	Format string
+	Output io.Writer
+}
+
+// New builds a logger that adds the request ID from the context to every
+// record logged with one.
+func New(options Options) (*slog.Logger, error) {
+	handlerOptions := &slog.HandlerOptions{
+		Level: options.Level,
+	}
+
+	var handler slog.Handler
+	switch options.Format {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(options.Output, handlerOptions)
+	case FormatText, "":
+		handler = slog.NewTextHandler(options.Output, handlerOptions)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", options.Format)
+	}
+
+	return slog.New(&contextHandler{Handler: handler}), nil
+}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,154 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "snippet_war"
+
+var registry = prometheus.NewRegistry()
+
+var (
+	grpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Total number of gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of gRPC requests by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Total number of HTTP requests by handler, method and status code.",
+	}, []string{"handler", "method", "code"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by handler and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "method"})
+
+	contentServiceRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "content_service",
+		Name:      "requests_total",
+		Help:      "Total number of content service request attempts by path and outcome.",
+	}, []string{"path", "outcome"})
+
+	contentServiceRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "content_service",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of content service request attempts by path and outcome.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30, 60},
+	}, []string{"path", "outcome"})
+
+	rateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "rate_limited_total",
+		Help:      "Total number of gRPC requests rejected by the rate limiter by method.",
+	}, []string{"method"})
+
+	questionsServed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "quiz",
+		Name:      "questions_served_total",
+		Help:      "Total number of questions served to players by language and difficulty.",
+	}, []string{"language", "difficulty"})
+
+	answersGraded = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "quiz",
+		Name:      "answers_graded_total",
+		Help:      "Total number of graded answers by language, difficulty and correctness.",
+	}, []string{"language", "difficulty", "correct"})
+
+	achievementsUnlocked = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "achievements",
+		Name:      "unlocked_total",
+		Help:      "Total number of unlocked achievements by achievement.",
+	}, []string{"achievement"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		grpcRequests,
+		grpcRequestDuration,
+		rateLimited,
+		httpRequests,
+		httpRequestDuration,
+		contentServiceRequests,
+		contentServiceRequestDuration,
+		questionsServed,
+		answersGraded,
+		achievementsUnlocked,
+	)
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// InstrumentHandler records requests served by next under the given handler name.
+func InstrumentHandler(name string, next http.Handler) http.Handler {
+	labels := prometheus.Labels{"handler": name}
+
+	return promhttp.InstrumentHandlerDuration(
+		httpRequestDuration.MustCurryWith(labels),
+		promhttp.InstrumentHandlerCounter(httpRequests.MustCurryWith(labels), next),
+	)
+}
+
+func ObserveGRPCRequest(method, code string, duration time.Duration) {
+	grpcRequests.WithLabelValues(method, code).Inc()
+	grpcRequestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+func RateLimited(method string) {
+	rateLimited.WithLabelValues(method).Inc()
+}
+
+func ObserveContentServiceRequest(path, outcome string, duration time.Duration) {
+	contentServiceRequests.WithLabelValues(path, outcome).Inc()
+	contentServiceRequestDuration.WithLabelValues(path, outcome).Observe(duration.Seconds())
+}
+
+func QuestionsServed(questions []*quiz_models.Question) {
+	for _, question := range questions {
+		questionsServed.WithLabelValues(question.Language.String(), question.Difficulty.String()).Inc()
+	}
+}
+
+func AnswerGraded(question *quiz_models.Question, correct bool) {
+	answersGraded.WithLabelValues(
+		question.Language.String(),
+		question.Difficulty.String(),
+		strconv.FormatBool(correct),
+	).Inc()
+}
+
+func AchievementUnlocked(id string) {
+	achievementsUnlocked.WithLabelValues(id).Inc()
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type CORSOptions struct {
+	// AllowedOrigins lists origins allowed to call the API, "*" allows any.
+	AllowedOrigins []string
+	AllowedHeaders []string
+	ExposedHeaders []string
+	MaxAge         time.Duration
+}
+
+// CORS answers preflight requests and sets CORS headers for allowed origins.
+// Requests from other origins pass through without the headers, so browsers
+// block them while same origin and non-browser clients keep working.
+type CORS struct {
+	options atomic.Pointer[CORSOptions]
+}
+
+func NewCORS(options CORSOptions) *CORS {
+	c := &CORS{}
+	c.SetOptions(options)
+
+	return c
+}
+
+func (c *CORS) SetOptions(options CORSOptions) {
+	c.options.Store(&options)
+}
+
+func (c *CORS) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		options := c.options.Load()
+		origin := r.Header.Get("Origin")
+
+		w.Header().Add("Vary", "Origin")
+
+		if origin == "" || !options.allowed(origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE")
+			if len(options.AllowedHeaders) > 0 {
+				w.Header().Set("Access-Control-Allow-Headers", strings.Join(options.AllowedHeaders, ", "))
+			}
+			if options.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(options.MaxAge.Seconds())))
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if len(options.ExposedHeaders) > 0 {
+			w.Header().Set("Access-Control-Expose-Headers", strings.Join(options.ExposedHeaders, ", "))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (o *CORSOptions) allowed(origin string) bool {
+	return slices.ContainsFunc(o.AllowedOrigins, func(allowed string) bool {
+		return allowed == "*" || strings.EqualFold(allowed, origin)
+	})
+}
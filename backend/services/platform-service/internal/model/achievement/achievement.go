@@ -0,0 +1,92 @@
+package achievement
+
+import (
+	"slices"
+	"time"
+
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+// Rule unlocks an achievement after Count successful events in its scope.
+// Success depends on the event: a correct answer in time, a won duel, a
+// session with every answer correct, or a streak of at least MinStreak days.
+type Rule struct {
+	ID           string
+	Names        catalog_models.Names
+	Descriptions catalog_models.Names
+	// Event is the name of the counted event.
+	Event string
+	// Language, Topic and Difficulty narrow the scope, empty matches any.
+	Language   quiz_models.Language
+	Topic      string
+	Difficulty quiz_models.Difficulty
+	Count      uint32
+	// Consecutive resets the count on a failed event in scope.
+	Consecutive bool
+	// AnyOutcome counts failed events too.
+	AnyOutcome bool
+	MinStreak  uint32
+}
+
+// Outcome is an event in the view of a single player.
+type Outcome struct {
+	UserID      int64
+	DisplayName string
+	Event       string
+	Language    quiz_models.Language
+	Topics      []string
+	Difficulty  quiz_models.Difficulty
+	Success     bool
+	Streak      uint32
+}
+
+func (r *Rule) Matches(outcome *Outcome) bool {
+	return r.Event == outcome.Event &&
+		(r.Language == quiz_models.LanguageUnspecified || r.Language == outcome.Language) &&
+		(r.Difficulty == quiz_models.DifficultyUnspecified || r.Difficulty == outcome.Difficulty) &&
+		(r.Topic == "" || slices.Contains(outcome.Topics, r.Topic))
+}
+
+// Counts reports whether the outcome adds to the count of the rule, false
+// means the outcome breaks a run of consecutive successes.
+func (r *Rule) Counts(outcome *Outcome) bool {
+	if r.AnyOutcome {
+		return true
+	}
+
+	if r.MinStreak > 0 {
+		return outcome.Streak >= r.MinStreak
+	}
+
+	return outcome.Success
+}
+
+// Progress of a user towards the achievement of a rule.
+type Progress struct {
+	UserID     int64
+	RuleID     string
+	Count      uint32
+	UnlockedAt *time.Time
+}
+
+func (p *Progress) Clone() *Progress {
+	clone := *p
+
+	if p.UnlockedAt != nil {
+		unlockedAt := *p.UnlockedAt
+		clone.UnlockedAt = &unlockedAt
+	}
+
+	return &clone
+}
+
+func (p *Progress) Unlocked() bool {
+	return p.UnlockedAt != nil
+}
+
+type Achievement struct {
+	Rule       *Rule
+	Count      uint32
+	UnlockedAt *time.Time
+}
@@ -0,0 +1,5 @@
+package achievement
+
+import "errors"
+
+var ErrInvalidRule = errors.New("invalid achievement rule")
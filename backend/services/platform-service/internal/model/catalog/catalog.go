@@ -0,0 +1,72 @@
+package catalog
+
+import (
+	"slices"
+	"strings"
+
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+// Names holds display names keyed by locale, e.g. "en" or "ru".
+type Names map[string]string
+
+// Get returns the name for locale, falling back to its base language
+// ("en-US" -> "en") and then to fallbackLocale.
+func (n Names) Get(locale, fallbackLocale string) string {
+	if name, ok := n[locale]; ok {
+		return name
+	}
+
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		if name, ok := n[base]; ok {
+			return name
+		}
+	}
+
+	return n[fallbackLocale]
+}
+
+type Language struct {
+	ID     quiz_models.Language
+	Names  Names
+	Topics []*Topic
+}
+
+func (l *Language) Topic(id string) *Topic {
+	for _, topic := range l.Topics {
+		if topic.ID == id {
+			return topic
+		}
+	}
+
+	return nil
+}
+
+// Difficulties returns difficulties available for at least one topic.
+func (l *Language) Difficulties() []quiz_models.Difficulty {
+	var difficulties []quiz_models.Difficulty
+	for _, difficulty := range []quiz_models.Difficulty{
+		quiz_models.DifficultyBeginner,
+		quiz_models.DifficultyIntermediate,
+		quiz_models.DifficultyAdvanced,
+	} {
+		for _, topic := range l.Topics {
+			if slices.Contains(topic.Difficulties, difficulty) {
+				difficulties = append(difficulties, difficulty)
+				break
+			}
+		}
+	}
+
+	return difficulties
+}
+
+type Topic struct {
+	ID           string
+	Names        Names
+	Difficulties []quiz_models.Difficulty
+}
+
+func (t *Topic) HasDifficulty(difficulty quiz_models.Difficulty) bool {
+	return slices.Contains(t.Difficulties, difficulty)
+}
@@ -0,0 +1,11 @@
+package catalog
+
+import "errors"
+
+var (
+	ErrUnknownLanguage = errors.New("unknown language")
+	ErrUnknownTopic    = errors.New("unknown topic")
+	// ErrTopicDifficulty is returned for a topic not offered at the requested
+	// difficulty.
+	ErrTopicDifficulty = errors.New("topic is not offered at difficulty")
+)
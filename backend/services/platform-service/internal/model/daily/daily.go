@@ -0,0 +1,135 @@
+package daily
+
+import (
+	"slices"
+	"time"
+
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+// Day returns midnight UTC of the day of t.
+func Day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Challenge is the question set of the day for a language, shared by all
+// players.
+type Challenge struct {
+	Date      time.Time
+	Language  quiz_models.Language
+	Questions []*quiz_models.Question
+	CreatedAt time.Time
+}
+
+type Attempt struct {
+	UserID      int64
+	DisplayName string
+	Date        time.Time
+	Language    quiz_models.Language
+	// Questions are copies issued to the user, with deadlines.
+	Questions []*quiz_models.Question
+	Answers   []*Answer
+	// GradingQuestionIDs are claimed by answers being graded, so concurrent
+	// answers to a question are graded once.
+	GradingQuestionIDs []string
+	Score              uint32
+	CorrectAnswers     uint32
+	StartedAt          time.Time
+	FinishedAt         *time.Time
+}
+
+func (a *Attempt) Clone() *Attempt {
+	clone := *a
+	clone.Questions = slices.Clone(a.Questions)
+	clone.GradingQuestionIDs = slices.Clone(a.GradingQuestionIDs)
+
+	clone.Answers = make([]*Answer, 0, len(a.Answers))
+	for _, answer := range a.Answers {
+		c := *answer
+		clone.Answers = append(clone.Answers, &c)
+	}
+
+	if a.FinishedAt != nil {
+		finishedAt := *a.FinishedAt
+		clone.FinishedAt = &finishedAt
+	}
+
+	return &clone
+}
+
+func (a *Attempt) HasQuestion(questionID string) bool {
+	return slices.ContainsFunc(a.Questions, func(question *quiz_models.Question) bool {
+		return question.ID == questionID
+	})
+}
+
+func (a *Attempt) Answer(questionID string) *Answer {
+	for _, answer := range a.Answers {
+		if answer.QuestionID == questionID {
+			return answer
+		}
+	}
+
+	return nil
+}
+
+// Duration is the time from start to finish, unfinished attempts take until
+// now.
+func (a *Attempt) Duration(now time.Time) time.Duration {
+	if a.FinishedAt != nil {
+		return a.FinishedAt.Sub(a.StartedAt)
+	}
+
+	return now.Sub(a.StartedAt)
+}
+
+type Answer struct {
+	QuestionID string
+	Correct    bool
+	Late       bool
+	Points     uint32
+}
+
+// Streak counts consecutive days with a finished challenge in any language.
+type Streak struct {
+	UserID   int64
+	Current  uint32
+	Best     uint32
+	LastDate time.Time
+}
+
+// Extend counts the day in the streak. It reports false for a day already
+// counted.
+func (s *Streak) Extend(date time.Time) bool {
+	switch {
+	case !s.LastDate.IsZero() && !date.After(s.LastDate):
+		return false
+	case s.LastDate.Equal(date.AddDate(0, 0, -1)):
+		s.Current++
+	default:
+		s.Current = 1
+	}
+
+	s.LastDate = date
+	s.Best = max(s.Best, s.Current)
+
+	return true
+}
+
+// Active returns the streak as of today, a missed day resets it.
+func (s *Streak) Active(today time.Time) *Streak {
+	clone := *s
+	if !s.LastDate.Equal(today) && !s.LastDate.Equal(today.AddDate(0, 0, -1)) {
+		clone.Current = 0
+	}
+
+	return &clone
+}
+
+// Progress is the challenge of the day as seen by a user.
+type Progress struct {
+	// Attempt is nil until the user starts it.
+	Attempt *Attempt
+	Streak  *Streak
+}
@@ -0,0 +1,12 @@
+package daily
+
+import "errors"
+
+var (
+	ErrChallengeNotFound = errors.New("daily challenge not found")
+	ErrAttemptNotFound   = errors.New("daily challenge attempt not found")
+	ErrAttemptFinished   = errors.New("daily challenge attempt already finished")
+	ErrQuestionNotInSet  = errors.New("question is not in the daily challenge")
+	ErrAlreadyAnswered   = errors.New("question is already answered")
+	ErrNoQuestions       = errors.New("no questions for the daily challenge")
+)
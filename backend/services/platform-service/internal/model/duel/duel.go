@@ -0,0 +1,184 @@
+package duel
+
+import (
+	"slices"
+	"time"
+
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type Status string
+
+func (s Status) String() string {
+	return string(s)
+}
+
+const (
+	StatusUnspecified Status = ""
+	StatusActive      Status = "active"
+	StatusFinished    Status = "finished"
+	// StatusAborted is set when questions for the duel could not be loaded.
+	StatusAborted Status = "aborted"
+)
+
+type Duel struct {
+	ID          string
+	Status      Status
+	Language    quiz_models.Language
+	Topics      []string
+	Difficulty  quiz_models.Difficulty
+	Players     []*Player
+	TotalRounds uint32
+	Rounds      []*Round
+	// WinnerID is zero until the duel is finished and for a draw.
+	WinnerID   int64
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+func (d *Duel) Clone() *Duel {
+	clone := *d
+	clone.Topics = slices.Clone(d.Topics)
+
+	clone.Players = make([]*Player, 0, len(d.Players))
+	for _, player := range d.Players {
+		p := *player
+		clone.Players = append(clone.Players, &p)
+	}
+
+	clone.Rounds = make([]*Round, 0, len(d.Rounds))
+	for _, round := range d.Rounds {
+		clone.Rounds = append(clone.Rounds, round.Clone())
+	}
+
+	if d.FinishedAt != nil {
+		finishedAt := *d.FinishedAt
+		clone.FinishedAt = &finishedAt
+	}
+
+	return &clone
+}
+
+func (d *Duel) Player(userID int64) *Player {
+	for _, player := range d.Players {
+		if player.UserID == userID {
+			return player
+		}
+	}
+
+	return nil
+}
+
+// CurrentRound returns the round waiting for answers, if any.
+func (d *Duel) CurrentRound() *Round {
+	if len(d.Rounds) == 0 {
+		return nil
+	}
+
+	round := d.Rounds[len(d.Rounds)-1]
+	if round.Finished {
+		return nil
+	}
+
+	return round
+}
+
+// DecideWinner ranks players by correct answers, then by total answer time.
+// Equal players make a draw.
+func (d *Duel) DecideWinner() {
+	d.WinnerID = 0
+
+	if len(d.Players) != 2 {
+		return
+	}
+
+	a, b := d.Players[0], d.Players[1]
+
+	switch {
+	case a.CorrectAnswers != b.CorrectAnswers:
+		if a.CorrectAnswers > b.CorrectAnswers {
+			d.WinnerID = a.UserID
+		} else {
+			d.WinnerID = b.UserID
+		}
+	case a.AnswerTime != b.AnswerTime:
+		if a.AnswerTime < b.AnswerTime {
+			d.WinnerID = a.UserID
+		} else {
+			d.WinnerID = b.UserID
+		}
+	}
+}
+
+type Player struct {
+	// UserID is the Telegram user ID, bots get negative IDs.
+	UserID         int64
+	DisplayName    string
+	Bot            bool
+	CorrectAnswers uint32
+	// AnswerTime is the sum of answer times of all rounds, unanswered rounds
+	// count as the full round time.
+	AnswerTime time.Duration
+}
+
+type Round struct {
+	Number   uint32
+	Question *quiz_models.Question
+	IssuedAt time.Time
+	Deadline time.Time
+	Answers  []*Answer
+	Finished bool
+}
+
+func (r *Round) Clone() *Round {
+	clone := *r
+
+	clone.Answers = make([]*Answer, 0, len(r.Answers))
+	for _, answer := range r.Answers {
+		a := *answer
+		clone.Answers = append(clone.Answers, &a)
+	}
+
+	return &clone
+}
+
+func (r *Round) Answer(userID int64) *Answer {
+	for _, answer := range r.Answers {
+		if answer.UserID == userID {
+			return answer
+		}
+	}
+
+	return nil
+}
+
+type Answer struct {
+	UserID   int64
+	Answered bool
+	Correct  bool
+	// Elapsed is measured by the server from issuing the question to receiving
+	// the answer.
+	Elapsed time.Duration
+}
+
+type EventType string
+
+func (e EventType) String() string {
+	return string(e)
+}
+
+const (
+	EventTypeUnspecified   EventType = ""
+	EventTypeWaiting       EventType = "waiting"
+	EventTypeMatched       EventType = "matched"
+	EventTypeQuestion      EventType = "question"
+	EventTypeRoundFinished EventType = "round_finished"
+	EventTypeFinished      EventType = "finished"
+)
+
+// Event is a snapshot of the duel streamed to players.
+type Event struct {
+	Type  EventType
+	Duel  *Duel
+	Round *Round
+}
@@ -0,0 +1,12 @@
+package duel
+
+import "errors"
+
+var (
+	ErrDuelNotFound       = errors.New("duel not found")
+	ErrDuelFinished       = errors.New("duel already finished")
+	ErrAlreadyQueued      = errors.New("player is already waiting for an opponent")
+	ErrNoTopics           = errors.New("no topics available for language and difficulty")
+	ErrQuestionNotCurrent = errors.New("question is not the current duel question")
+	ErrAlreadyAnswered    = errors.New("current question is already answered")
+)
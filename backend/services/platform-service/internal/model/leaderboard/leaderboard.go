@@ -0,0 +1,63 @@
+package leaderboard
+
+import (
+	"time"
+
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type Period string
+
+func (p Period) String() string {
+	return string(p)
+}
+
+const (
+	PeriodUnspecified Period = ""
+	PeriodAllTime     Period = "all_time"
+	PeriodWeekly      Period = "weekly"
+	PeriodDaily       Period = "daily"
+)
+
+type Score struct {
+	UserID      int64
+	DisplayName string
+	Language    quiz_models.Language
+	Difficulty  quiz_models.Difficulty
+	Points      uint32
+	ScoredAt    time.Time
+}
+
+type Filter struct {
+	Since      time.Time
+	Language   quiz_models.Language
+	Difficulty quiz_models.Difficulty
+}
+
+func (f Filter) Match(score *Score) bool {
+	if score.ScoredAt.Before(f.Since) {
+		return false
+	}
+
+	if f.Language != quiz_models.LanguageUnspecified && f.Language != score.Language {
+		return false
+	}
+
+	if f.Difficulty != quiz_models.DifficultyUnspecified && f.Difficulty != score.Difficulty {
+		return false
+	}
+
+	return true
+}
+
+type Entry struct {
+	Rank        uint32
+	UserID      int64
+	DisplayName string
+	Score       uint64
+}
+
+type Leaderboard struct {
+	Entries []*Entry
+	Me      *Entry
+}
@@ -0,0 +1,50 @@
+package profile
+
+import (
+	"time"
+
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+// Answer is the first graded answer of a user to a question.
+type Answer struct {
+	UserID     int64
+	QuestionID string
+	Language   quiz_models.Language
+	Topic      string
+	Difficulty quiz_models.Difficulty
+	Correct    bool
+	AnsweredAt time.Time
+}
+
+// Stats aggregates answers of a user per language, topic and difficulty.
+type Stats struct {
+	Language   quiz_models.Language
+	Topic      string
+	Difficulty quiz_models.Difficulty
+	Answered   uint32
+	Correct    uint32
+}
+
+type Profile struct {
+	UserID      int64
+	DisplayName string
+	XP          uint64
+	Level       uint32
+	LevelXP     uint64
+	NextLevelXP uint64
+	Answered    uint32
+	Correct     uint32
+	// Accuracy is the share of correct answers, from 0 to 1.
+	Accuracy float64
+	Mastery  []*Mastery
+}
+
+type Mastery struct {
+	Language quiz_models.Language
+	Topic    string
+	Answered uint32
+	Correct  uint32
+	// Score is from 0 to 100.
+	Score uint32
+}
@@ -0,0 +1,9 @@
+package quiz
+
+import "errors"
+
+var (
+	ErrQuestionNotFound   = errors.New("question not found")
+	ErrAnswerTypeMismatch = errors.New("answer type does not match question")
+	ErrAlreadyAnswered    = errors.New("question already answered")
+)
@@ -1,5 +1,7 @@
 package quiz
 
+import "time"
+
 type Question struct {
 	ID          string
 	Language    Language
@@ -8,6 +10,19 @@ type Question struct {
 	Content     Content
 	Explanation string
 	Answer      Answer
+	// Deadline is set on copies served to a user, zero means no time limit.
+	Deadline time.Time
+}
+
+func (q *Question) CorrectAnswers() []string {
+	switch answer := q.Answer.(type) {
+	case *MultipleChoiceAnswer:
+		return answer.CorrectOptions
+	case *FreeTextAnswer:
+		return answer.CorrectAnswers
+	default:
+		return nil
+	}
 }
 
 type Content struct {
@@ -55,6 +70,8 @@ const (
 	AnswerTypeUnspecified    AnswerType = ""
 	AnswerTypeMultipleChoice AnswerType = "multiple_choice"
 	AnswerTypeFreeText       AnswerType = "free_text"
+	// AnswerTypeMixed is only used to request questions of both types.
+	AnswerTypeMixed AnswerType = "mixed"
 )
 
 type Answer interface {
@@ -77,3 +94,61 @@ type FreeTextAnswer struct {
 func (a *FreeTextAnswer) AnswerType() AnswerType {
 	return AnswerTypeFreeText
 }
+
+type UserAnswer interface {
+	AnswerType() AnswerType
+}
+
+type MultipleChoiceUserAnswer struct {
+	SelectedOptions []string
+}
+
+func (a *MultipleChoiceUserAnswer) AnswerType() AnswerType {
+	return AnswerTypeMultipleChoice
+}
+
+type FreeTextUserAnswer struct {
+	Text string
+}
+
+func (a *FreeTextUserAnswer) AnswerType() AnswerType {
+	return AnswerTypeFreeText
+}
+
+type AnswerResult struct {
+	QuestionID     string
+	Correct        bool
+	CorrectAnswers []string
+	Explanation    string
+	// Points include SpeedBonus.
+	Points     uint32
+	SpeedBonus uint32
+	Late       bool
+}
+
+// Mode is the game mode a question is issued for, answers are graded only
+// through the same mode.
+type Mode string
+
+const (
+	ModeQuiz    Mode = "quiz"
+	ModeSession Mode = "session"
+	ModeDuel    Mode = "duel"
+	ModeDaily   Mode = "daily"
+)
+
+// Issue records when a question was served to a user.
+type Issue struct {
+	UserID     int64
+	QuestionID string
+	Mode       Mode
+	IssuedAt   time.Time
+	// Deadline is zero when the question has no time limit.
+	Deadline time.Time
+	// AnsweredAt is set once the issued question is answered.
+	AnsweredAt *time.Time
+}
+
+func (i *Issue) Late(answeredAt time.Time) bool {
+	return !i.Deadline.IsZero() && answeredAt.After(i.Deadline)
+}
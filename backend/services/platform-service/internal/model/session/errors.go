@@ -0,0 +1,11 @@
+package session
+
+import "errors"
+
+var (
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrSessionFinished    = errors.New("session already finished")
+	ErrNoQuestionsLeft    = errors.New("no questions left in session")
+	ErrAnswerPending      = errors.New("current question is not answered yet")
+	ErrQuestionNotCurrent = errors.New("question is not the current session question")
+)
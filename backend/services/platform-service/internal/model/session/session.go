@@ -0,0 +1,58 @@
+package session
+
+import (
+	"slices"
+	"time"
+
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type Status string
+
+func (s Status) String() string {
+	return string(s)
+}
+
+const (
+	StatusUnspecified Status = ""
+	StatusActive      Status = "active"
+	StatusFinished    Status = "finished"
+)
+
+type Session struct {
+	ID                string
+	UserID            int64
+	Status            Status
+	Language          quiz_models.Language
+	Topics            []string
+	Difficulty        quiz_models.Difficulty
+	AnswerType        quiz_models.AnswerType
+	TotalQuestions    uint32
+	AnsweredQuestions uint32
+	CorrectAnswers    uint32
+	Score             uint32
+	CurrentQuestionID string
+	// GradingQuestionID is the current question while its answer is graded,
+	// it keeps concurrent answers from being graded twice.
+	GradingQuestionID string
+	ServedQuestionIDs []string
+	StartedAt         time.Time
+	FinishedAt        *time.Time
+}
+
+func (s *Session) Clone() *Session {
+	clone := *s
+	clone.Topics = slices.Clone(s.Topics)
+	clone.ServedQuestionIDs = slices.Clone(s.ServedQuestionIDs)
+
+	if s.FinishedAt != nil {
+		finishedAt := *s.FinishedAt
+		clone.FinishedAt = &finishedAt
+	}
+
+	return &clone
+}
+
+func (s *Session) RemainingQuestions() uint32 {
+	return s.TotalQuestions - uint32(len(s.ServedQuestionIDs))
+}
@@ -0,0 +1,153 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Limit allows Count requests per Period with bursts up to Count.
+type Limit struct {
+	Count  int
+	Period time.Duration
+}
+
+type Options struct {
+	// PerUser and PerIP map full gRPC method names to limits. Methods
+	// without a limit are not limited by that key.
+	PerUser map[string]Limit
+	PerIP   map[string]Limit
+}
+
+type bucketKey struct {
+	method string
+	kind   string
+	key    string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	period   time.Duration
+	lastSeen time.Time
+}
+
+// Limiter keeps a token bucket per method and user or client IP.
+type Limiter struct {
+	mu      sync.Mutex
+	options Options
+	buckets map[bucketKey]*bucket
+	now     func() time.Time
+}
+
+func New(options Options) *Limiter {
+	return &Limiter{
+		options: options,
+		buckets: make(map[bucketKey]*bucket),
+		now:     time.Now,
+	}
+}
+
+// SetOptions replaces limits. Buckets of methods whose limit changed are
+// dropped, so those keys start with a full bucket under the new limit, the
+// others keep their tokens.
+func (l *Limiter) SetOptions(options Options) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key := range l.buckets {
+		if limitOf(l.options, key) != limitOf(options, key) {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.options = options
+}
+
+// Allow takes a token for the method from both the user and the IP buckets.
+// If either is empty nothing is taken and the wait until a retry can succeed
+// is returned.
+func (l *Limiter) Allow(method, user, ip string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	var reservations []*rate.Reservation
+	if limit, ok := l.options.PerUser[method]; ok && user != "" {
+		reservations = append(reservations, l.bucket(bucketKey{method, "user", user}, limit, now).ReserveN(now, 1))
+	}
+	if limit, ok := l.options.PerIP[method]; ok && ip != "" {
+		reservations = append(reservations, l.bucket(bucketKey{method, "ip", ip}, limit, now).ReserveN(now, 1))
+	}
+
+	var retryAfter time.Duration
+	for _, reservation := range reservations {
+		retryAfter = max(retryAfter, reservation.DelayFrom(now))
+	}
+
+	if retryAfter == 0 {
+		return true, 0
+	}
+
+	for _, reservation := range reservations {
+		reservation.CancelAt(now)
+	}
+
+	return false, retryAfter
+}
+
+// Run drops idle buckets until ctx is done.
+func (l *Limiter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			l.cleanup()
+		}
+	}
+}
+
+func (l *Limiter) bucket(key bucketKey, limit Limit, now time.Time) *rate.Limiter {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{
+			limiter: rate.NewLimiter(rate.Limit(float64(limit.Count)/limit.Period.Seconds()), limit.Count),
+			period:  limit.Period,
+		}
+		l.buckets[key] = b
+	}
+
+	b.lastSeen = now
+
+	return b.limiter
+}
+
+// limitOf returns the limit the bucket was created with, zero when the
+// method is not limited by its kind of key.
+func limitOf(options Options, key bucketKey) Limit {
+	if key.kind == "user" {
+		return options.PerUser[key.method]
+	}
+
+	return options.PerIP[key.method]
+}
+
+// cleanup drops buckets that have been refilled completely, they are
+// indistinguishable from new ones.
+func (l *Limiter) cleanup() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) > b.period {
+			delete(l.buckets, key)
+		}
+	}
+}
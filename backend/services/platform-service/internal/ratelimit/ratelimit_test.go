@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+const (
+	startSession = "/session.GameSession/StartSession"
+	getQuestions = "/quiz.Quiz/GetQuestions"
+)
+
+func newTestLimiter() *Limiter {
+	l := New(Options{
+		PerUser: map[string]Limit{
+			startSession: {Count: 1, Period: time.Minute},
+			getQuestions: {Count: 1, Period: time.Minute},
+		},
+	})
+
+	now := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	l.now = func() time.Time {
+		return now
+	}
+
+	return l
+}
+
+func TestAllowTakesFromUserAndIPBuckets(t *testing.T) {
+	l := newTestLimiter()
+	l.options.PerIP = map[string]Limit{startSession: {Count: 2, Period: time.Minute}}
+
+	if ok, _ := l.Allow(startSession, "1", "10.0.0.1"); !ok {
+		t.Fatalf("first request was limited")
+	}
+
+	ok, retryAfter := l.Allow(startSession, "1", "10.0.0.1")
+	if ok || retryAfter != time.Minute {
+		t.Fatalf("got %v, retry after %v for an empty user bucket, want limited for a minute", ok, retryAfter)
+	}
+
+	// The rejected request took no token from the IP bucket.
+	if ok, _ = l.Allow(startSession, "2", "10.0.0.1"); !ok {
+		t.Errorf("another user behind the same IP was limited")
+	}
+}
+
+func TestSetOptionsKeepsUnchangedBuckets(t *testing.T) {
+	l := newTestLimiter()
+
+	for _, method := range []string{startSession, getQuestions} {
+		if ok, _ := l.Allow(method, "1", ""); !ok {
+			t.Fatalf("first %s was limited", method)
+		}
+	}
+
+	l.SetOptions(Options{
+		PerUser: map[string]Limit{
+			startSession: {Count: 1, Period: time.Minute},
+			getQuestions: {Count: 5, Period: time.Minute},
+		},
+	})
+
+	if ok, _ := l.Allow(startSession, "1", ""); ok {
+		t.Errorf("reload with the same limit refilled the bucket")
+	}
+
+	if ok, _ := l.Allow(getQuestions, "1", ""); !ok {
+		t.Errorf("bucket with a changed limit was kept")
+	}
+}
@@ -0,0 +1,65 @@
+package achievement
+
+import (
+	"context"
+	"sync"
+
+	models "github.com/casnerano/snippet-war/internal/model/achievement"
+)
+
+type progressKey struct {
+	userID int64
+	ruleID string
+}
+
+type Memory struct {
+	mu       sync.RWMutex
+	progress map[progressKey]*models.Progress
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		progress: make(map[progressKey]*models.Progress),
+	}
+}
+
+func (m *Memory) ListProgress(_ context.Context, userID int64) ([]*models.Progress, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var progress []*models.Progress
+	for key, p := range m.progress {
+		if key.userID == userID {
+			progress = append(progress, p.Clone())
+		}
+	}
+
+	return progress, nil
+}
+
+// UpdateProgress applies update to the progress of the user, new progress
+// starts from zero.
+func (m *Memory) UpdateProgress(
+	_ context.Context,
+	userID int64,
+	ruleID string,
+	update func(progress *models.Progress) error,
+) (*models.Progress, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := progressKey{userID: userID, ruleID: ruleID}
+
+	updated := &models.Progress{UserID: userID, RuleID: ruleID}
+	if p, ok := m.progress[key]; ok {
+		updated = p.Clone()
+	}
+
+	if err := update(updated); err != nil {
+		return nil, err
+	}
+
+	m.progress[key] = updated
+
+	return updated.Clone(), nil
+}
@@ -0,0 +1,217 @@
+package daily
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/daily"
+	leaderboard_models "github.com/casnerano/snippet-war/internal/model/leaderboard"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type challengeKey struct {
+	language quiz_models.Language
+	date     string
+}
+
+type attemptKey struct {
+	challengeKey
+	userID int64
+}
+
+func keyOf(language quiz_models.Language, date time.Time) challengeKey {
+	return challengeKey{
+		language: language,
+		date:     date.Format(time.DateOnly),
+	}
+}
+
+type Memory struct {
+	mu         sync.RWMutex
+	challenges map[challengeKey]*models.Challenge
+	attempts   map[attemptKey]*models.Attempt
+	streaks    map[int64]*models.Streak
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		challenges: make(map[challengeKey]*models.Challenge),
+		attempts:   make(map[attemptKey]*models.Attempt),
+		streaks:    make(map[int64]*models.Streak),
+	}
+}
+
+func (m *Memory) GetChallenge(_ context.Context, language quiz_models.Language, date time.Time) (*models.Challenge, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	challenge, ok := m.challenges[keyOf(language, date)]
+	if !ok {
+		return nil, models.ErrChallengeNotFound
+	}
+
+	return challenge, nil
+}
+
+// SaveChallenge keeps the first challenge saved for the day and returns it.
+func (m *Memory) SaveChallenge(_ context.Context, challenge *models.Challenge) (*models.Challenge, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := keyOf(challenge.Language, challenge.Date)
+	if saved, ok := m.challenges[key]; ok {
+		return saved, nil
+	}
+
+	m.challenges[key] = challenge
+
+	return challenge, nil
+}
+
+func (m *Memory) GetAttempt(_ context.Context, language quiz_models.Language, date time.Time, userID int64) (*models.Attempt, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	attempt, ok := m.attempts[attemptKey{keyOf(language, date), userID}]
+	if !ok {
+		return nil, models.ErrAttemptNotFound
+	}
+
+	return attempt.Clone(), nil
+}
+
+// CreateAttempt keeps the first attempt of the user and returns it.
+func (m *Memory) CreateAttempt(_ context.Context, attempt *models.Attempt) (*models.Attempt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := attemptKey{keyOf(attempt.Language, attempt.Date), attempt.UserID}
+	if saved, ok := m.attempts[key]; ok {
+		return saved.Clone(), nil
+	}
+
+	m.attempts[key] = attempt.Clone()
+
+	return attempt, nil
+}
+
+func (m *Memory) UpdateAttempt(
+	_ context.Context,
+	language quiz_models.Language,
+	date time.Time,
+	userID int64,
+	update func(attempt *models.Attempt) error,
+) (*models.Attempt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := attemptKey{keyOf(language, date), userID}
+
+	attempt, ok := m.attempts[key]
+	if !ok {
+		return nil, models.ErrAttemptNotFound
+	}
+
+	updated := attempt.Clone()
+	if err := update(updated); err != nil {
+		return nil, err
+	}
+
+	m.attempts[key] = updated
+
+	return updated.Clone(), nil
+}
+
+func (m *Memory) Top(_ context.Context, language quiz_models.Language, date time.Time, limit uint32) ([]*leaderboard_models.Entry, error) {
+	entries := m.rank(language, date)
+	if uint32(len(entries)) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries, nil
+}
+
+func (m *Memory) Rank(_ context.Context, language quiz_models.Language, date time.Time, userID int64) (*leaderboard_models.Entry, error) {
+	for _, entry := range m.rank(language, date) {
+		if entry.UserID == userID {
+			return entry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// rank orders attempts with answers by score, then by the faster attempt.
+func (m *Memory) rank(language quiz_models.Language, date time.Time) []*leaderboard_models.Entry {
+	now := time.Now()
+	key := keyOf(language, date)
+
+	m.mu.RLock()
+
+	var attempts []*models.Attempt
+	for k, attempt := range m.attempts {
+		if k.challengeKey == key && len(attempt.Answers) > 0 {
+			attempts = append(attempts, attempt.Clone())
+		}
+	}
+
+	m.mu.RUnlock()
+
+	slices.SortFunc(attempts, func(a, b *models.Attempt) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Duration(now), b.Duration(now)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+
+	entries := make([]*leaderboard_models.Entry, 0, len(attempts))
+	for idx, attempt := range attempts {
+		entries = append(entries, &leaderboard_models.Entry{
+			Rank:        uint32(idx + 1),
+			UserID:      attempt.UserID,
+			DisplayName: attempt.DisplayName,
+			Score:       uint64(attempt.Score),
+		})
+	}
+
+	return entries
+}
+
+func (m *Memory) GetStreak(_ context.Context, userID int64) (*models.Streak, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	streak, ok := m.streaks[userID]
+	if !ok {
+		return &models.Streak{UserID: userID}, nil
+	}
+
+	clone := *streak
+
+	return &clone, nil
+}
+
+func (m *Memory) UpdateStreak(_ context.Context, userID int64, update func(streak *models.Streak) error) (*models.Streak, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	streak := models.Streak{UserID: userID}
+	if saved, ok := m.streaks[userID]; ok {
+		streak = *saved
+	}
+
+	if err := update(&streak); err != nil {
+		return nil, err
+	}
+
+	m.streaks[userID] = &streak
+	clone := streak
+
+	return &clone, nil
+}
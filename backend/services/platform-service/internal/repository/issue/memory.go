@@ -0,0 +1,81 @@
+package issue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+// key includes the mode, the same question may be issued to a user in a quiz
+// and in a daily challenge independently.
+type key struct {
+	userID     int64
+	questionID string
+	mode       models.Mode
+}
+
+type Memory struct {
+	mu     sync.Mutex
+	ttl    time.Duration
+	issues map[key]*models.Issue
+}
+
+func NewMemory(ttl time.Duration) *Memory {
+	return &Memory{
+		ttl:    ttl,
+		issues: make(map[key]*models.Issue),
+	}
+}
+
+// Save stores issues, replacing unanswered issues of the same question.
+// Answered issues are kept until they expire, so a question served again,
+// e.g. from the question bank, is not graded twice.
+func (m *Memory) Save(_ context.Context, issues []*models.Issue) error {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for k, issue := range m.issues {
+		if now.After(issue.IssuedAt.Add(m.ttl)) {
+			delete(m.issues, k)
+		}
+	}
+
+	for _, issue := range issues {
+		k := key{userID: issue.UserID, questionID: issue.QuestionID, mode: issue.Mode}
+		if existing, ok := m.issues[k]; ok && existing.AnsweredAt != nil {
+			continue
+		}
+
+		clone := *issue
+		m.issues[k] = &clone
+	}
+
+	return nil
+}
+
+// Consume marks the question issued to the user in the mode as answered and
+// returns the issue. Questions issued in other modes are not found, so they
+// can only be answered through their own mode.
+func (m *Memory) Consume(_ context.Context, userID int64, questionID string, mode models.Mode, answeredAt time.Time) (*models.Issue, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	issue, ok := m.issues[key{userID: userID, questionID: questionID, mode: mode}]
+	if !ok || answeredAt.After(issue.IssuedAt.Add(m.ttl)) {
+		return nil, models.ErrQuestionNotFound
+	}
+
+	if issue.AnsweredAt != nil {
+		return nil, models.ErrAlreadyAnswered
+	}
+
+	issue.AnsweredAt = &answeredAt
+
+	clone := *issue
+
+	return &clone, nil
+}
@@ -0,0 +1,97 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+const bankQuestionID = "bank-go-slices-append"
+
+func issue(mode models.Mode, issuedAt time.Time) *models.Issue {
+	return &models.Issue{
+		UserID:     1,
+		QuestionID: bankQuestionID,
+		Mode:       mode,
+		IssuedAt:   issuedAt,
+		Deadline:   issuedAt.Add(time.Minute),
+	}
+}
+
+func save(t *testing.T, m *Memory, issues ...*models.Issue) {
+	t.Helper()
+
+	if err := m.Save(context.Background(), issues); err != nil {
+		t.Fatalf("save issues: %v", err)
+	}
+}
+
+func TestReissueAfterAnswerKeepsAnswer(t *testing.T) {
+	m := NewMemory(time.Hour)
+	now := time.Now()
+
+	save(t, m, issue(models.ModeQuiz, now))
+
+	if _, err := m.Consume(context.Background(), 1, bankQuestionID, models.ModeQuiz, now); err != nil {
+		t.Fatalf("consume: %v", err)
+	}
+
+	// The bank serves the same question again.
+	save(t, m, issue(models.ModeQuiz, now.Add(time.Second)))
+
+	if _, err := m.Consume(context.Background(), 1, bankQuestionID, models.ModeQuiz, now.Add(2*time.Second)); !errors.Is(err, models.ErrAlreadyAnswered) {
+		t.Errorf("got error %v after re-issue, want ErrAlreadyAnswered", err)
+	}
+}
+
+func TestReissueBeforeAnswerRefreshesIssue(t *testing.T) {
+	m := NewMemory(time.Hour)
+	now := time.Now()
+
+	save(t, m, issue(models.ModeQuiz, now))
+	save(t, m, issue(models.ModeQuiz, now.Add(time.Minute)))
+
+	got, err := m.Consume(context.Background(), 1, bankQuestionID, models.ModeQuiz, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("consume: %v", err)
+	}
+
+	if !got.IssuedAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("got issue from %v, want the latest one", got.IssuedAt)
+	}
+}
+
+func TestIssuesOfModesAreIndependent(t *testing.T) {
+	m := NewMemory(time.Hour)
+	now := time.Now()
+
+	save(t, m, issue(models.ModeDaily, now))
+	// A quiz serves the same bank question after the daily challenge did.
+	save(t, m, issue(models.ModeQuiz, now))
+
+	if _, err := m.Consume(context.Background(), 1, bankQuestionID, models.ModeDaily, now); err != nil {
+		t.Fatalf("consume daily issue: %v", err)
+	}
+
+	if _, err := m.Consume(context.Background(), 1, bankQuestionID, models.ModeQuiz, now); err != nil {
+		t.Fatalf("consume quiz issue: %v", err)
+	}
+
+	if _, err := m.Consume(context.Background(), 1, bankQuestionID, models.ModeSession, now); !errors.Is(err, models.ErrQuestionNotFound) {
+		t.Errorf("got error %v for a mode without an issue, want ErrQuestionNotFound", err)
+	}
+}
+
+func TestExpiredIssueIsNotFound(t *testing.T) {
+	m := NewMemory(time.Hour)
+	now := time.Now()
+
+	save(t, m, issue(models.ModeQuiz, now))
+
+	if _, err := m.Consume(context.Background(), 1, bankQuestionID, models.ModeQuiz, now.Add(2*time.Hour)); !errors.Is(err, models.ErrQuestionNotFound) {
+		t.Errorf("got error %v, want ErrQuestionNotFound", err)
+	}
+}
@@ -0,0 +1,109 @@
+package leaderboard
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/leaderboard"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type bucketKey struct {
+	userID     int64
+	language   quiz_models.Language
+	difficulty quiz_models.Difficulty
+	day        time.Time
+}
+
+// Memory sums scores on write per user, language, difficulty and UTC day, so
+// its size does not grow with the number of answers. Filters match whole
+// days, Since is expected to be a midnight UTC.
+type Memory struct {
+	mu           sync.RWMutex
+	buckets      map[bucketKey]uint64
+	displayNames map[int64]string
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		buckets:      make(map[bucketKey]uint64),
+		displayNames: make(map[int64]string),
+	}
+}
+
+func (m *Memory) AddScore(_ context.Context, score *models.Score) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	scoredAt := score.ScoredAt.UTC()
+
+	m.buckets[bucketKey{
+		userID:     score.UserID,
+		language:   score.Language,
+		difficulty: score.Difficulty,
+		day:        time.Date(scoredAt.Year(), scoredAt.Month(), scoredAt.Day(), 0, 0, 0, 0, time.UTC),
+	}] += uint64(score.Points)
+	m.displayNames[score.UserID] = score.DisplayName
+
+	return nil
+}
+
+func (m *Memory) Top(_ context.Context, filter models.Filter, limit uint32) ([]*models.Entry, error) {
+	entries := m.rank(filter)
+	if uint32(len(entries)) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries, nil
+}
+
+func (m *Memory) Rank(_ context.Context, filter models.Filter, userID int64) (*models.Entry, error) {
+	for _, entry := range m.rank(filter) {
+		if entry.UserID == userID {
+			return entry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (m *Memory) rank(filter models.Filter) []*models.Entry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	totals := make(map[int64]*models.Entry)
+	for key, points := range m.buckets {
+		if !filter.Match(&models.Score{Language: key.language, Difficulty: key.difficulty, ScoredAt: key.day}) {
+			continue
+		}
+
+		entry, ok := totals[key.userID]
+		if !ok {
+			entry = &models.Entry{UserID: key.userID, DisplayName: m.displayNames[key.userID]}
+			totals[key.userID] = entry
+		}
+
+		entry.Score += points
+	}
+
+	entries := make([]*models.Entry, 0, len(totals))
+	for _, entry := range totals {
+		entries = append(entries, entry)
+	}
+
+	slices.SortFunc(entries, func(a, b *models.Entry) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+
+	for idx, entry := range entries {
+		entry.Rank = uint32(idx + 1)
+	}
+
+	return entries
+}
@@ -0,0 +1,157 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/leaderboard"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+var testDay = time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+
+func addScores(t *testing.T, m *Memory, scores ...*models.Score) {
+	t.Helper()
+
+	for _, score := range scores {
+		if err := m.AddScore(context.Background(), score); err != nil {
+			t.Fatalf("add score: %v", err)
+		}
+	}
+}
+
+func score(userID int64, language quiz_models.Language, difficulty quiz_models.Difficulty, points uint32, scoredAt time.Time) *models.Score {
+	return &models.Score{
+		UserID:      userID,
+		DisplayName: "player",
+		Language:    language,
+		Difficulty:  difficulty,
+		Points:      points,
+		ScoredAt:    scoredAt,
+	}
+}
+
+func newTestMemory(t *testing.T) *Memory {
+	t.Helper()
+
+	m := NewMemory()
+	addScores(t, m,
+		score(1, quiz_models.LanguageGo, quiz_models.DifficultyBeginner, 10, testDay.Add(time.Hour)),
+		score(1, quiz_models.LanguageGo, quiz_models.DifficultyBeginner, 10, testDay.Add(23*time.Hour)),
+		score(1, quiz_models.LanguagePython, quiz_models.DifficultyAdvanced, 30, testDay.AddDate(0, 0, -3)),
+		score(2, quiz_models.LanguageGo, quiz_models.DifficultyAdvanced, 25, testDay.Add(2*time.Hour)),
+		score(3, quiz_models.LanguageGo, quiz_models.DifficultyBeginner, 20, testDay.AddDate(0, 0, -1)),
+	)
+
+	return m
+}
+
+func entryScores(entries []*models.Entry) map[int64]uint64 {
+	scores := make(map[int64]uint64, len(entries))
+	for _, entry := range entries {
+		scores[entry.UserID] = entry.Score
+	}
+
+	return scores
+}
+
+func TestMemoryAggregatesScores(t *testing.T) {
+	m := newTestMemory(t)
+
+	// Two answers of the same user, language, difficulty and day share a
+	// bucket.
+	if got := len(m.buckets); got != 4 {
+		t.Errorf("got %d buckets, want 4", got)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		filter models.Filter
+		want   map[int64]uint64
+	}{
+		{
+			name: "all time",
+			want: map[int64]uint64{1: 50, 2: 25, 3: 20},
+		},
+		{
+			name:   "today",
+			filter: models.Filter{Since: testDay},
+			want:   map[int64]uint64{1: 20, 2: 25},
+		},
+		{
+			name:   "language",
+			filter: models.Filter{Language: quiz_models.LanguageGo},
+			want:   map[int64]uint64{1: 20, 2: 25, 3: 20},
+		},
+		{
+			name:   "difficulty since yesterday",
+			filter: models.Filter{Since: testDay.AddDate(0, 0, -1), Difficulty: quiz_models.DifficultyBeginner},
+			want:   map[int64]uint64{1: 20, 3: 20},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := m.Top(context.Background(), tc.filter, 10)
+			if err != nil {
+				t.Fatalf("top: %v", err)
+			}
+
+			got := entryScores(entries)
+			if len(got) != len(tc.want) {
+				t.Fatalf("got scores %v, want %v", got, tc.want)
+			}
+
+			for userID, points := range tc.want {
+				if got[userID] != points {
+					t.Errorf("got scores %v, want %v", got, tc.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryRanksByScoreThenUser(t *testing.T) {
+	m := newTestMemory(t)
+	filter := models.Filter{Language: quiz_models.LanguageGo}
+
+	entries, err := m.Top(context.Background(), filter, 2)
+	if err != nil {
+		t.Fatalf("top: %v", err)
+	}
+
+	// Users 1 and 3 tie with 20 points, the lower id ranks first.
+	if len(entries) != 2 || entries[0].UserID != 2 || entries[1].UserID != 1 || entries[1].Rank != 2 {
+		t.Fatalf("got top scores %v, want user 2 then user 1", entryScores(entries))
+	}
+
+	entry, err := m.Rank(context.Background(), filter, 3)
+	if err != nil {
+		t.Fatalf("rank: %v", err)
+	}
+
+	if entry == nil || entry.Rank != 3 || entry.Score != 20 {
+		t.Errorf("got entry %+v, want rank 3 with 20 points", entry)
+	}
+
+	if entry, err = m.Rank(context.Background(), models.Filter{Since: testDay}, 3); err != nil || entry != nil {
+		t.Errorf("got entry %+v, %v for a user without scores today, want nil", entry, err)
+	}
+}
+
+func TestMemoryKeepsLatestDisplayName(t *testing.T) {
+	m := NewMemory()
+
+	renamed := score(1, quiz_models.LanguageGo, quiz_models.DifficultyBeginner, 10, testDay)
+	renamed.DisplayName = "@ada"
+	addScores(t, m, score(1, quiz_models.LanguageGo, quiz_models.DifficultyBeginner, 10, testDay), renamed)
+
+	entry, err := m.Rank(context.Background(), models.Filter{}, 1)
+	if err != nil {
+		t.Fatalf("rank: %v", err)
+	}
+
+	if entry.DisplayName != "@ada" || entry.Score != 20 {
+		t.Errorf("got entry %+v, want @ada with 20 points", entry)
+	}
+}
@@ -0,0 +1,86 @@
+package profile
+
+import (
+	"context"
+	"sync"
+
+	models "github.com/casnerano/snippet-war/internal/model/profile"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type statsKey struct {
+	language   quiz_models.Language
+	topic      string
+	difficulty quiz_models.Difficulty
+}
+
+type Memory struct {
+	mu      sync.RWMutex
+	answers map[int64]map[string]*models.Answer
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		answers: make(map[int64]map[string]*models.Answer),
+	}
+}
+
+// RecordAnswer keeps the first answer of the user to the question. Answers to
+// questions outside of the database are ignored as by Postgres.
+func (m *Memory) RecordAnswer(_ context.Context, answer *models.Answer) error {
+	if _, ok := questionUUID(answer.QuestionID); !ok {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	answers, ok := m.answers[answer.UserID]
+	if !ok {
+		answers = make(map[string]*models.Answer)
+		m.answers[answer.UserID] = answers
+	}
+
+	if _, ok = answers[answer.QuestionID]; !ok {
+		answerCopy := *answer
+		answers[answer.QuestionID] = &answerCopy
+	}
+
+	return nil
+}
+
+func (m *Memory) ListStats(_ context.Context, userID int64) ([]*models.Stats, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats := make(map[statsKey]*models.Stats)
+	for _, answer := range m.answers[userID] {
+		key := statsKey{
+			language:   answer.Language,
+			topic:      answer.Topic,
+			difficulty: answer.Difficulty,
+		}
+
+		s, ok := stats[key]
+		if !ok {
+			s = &models.Stats{
+				Language:   answer.Language,
+				Topic:      answer.Topic,
+				Difficulty: answer.Difficulty,
+			}
+			stats[key] = s
+		}
+
+		s.Answered++
+		if answer.Correct {
+			s.Correct++
+		}
+	}
+
+	list := make([]*models.Stats, 0, len(stats))
+	for _, s := range stats {
+		list = append(list, s)
+	}
+
+	return list, nil
+}
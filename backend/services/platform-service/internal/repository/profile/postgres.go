@@ -0,0 +1,106 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/casnerano/snippet-war/internal/model/profile"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// recordAnswerQuery creates the user on the first answer and stores the
+// answer in the row the content service creates when a question is seen.
+// Answers to questions missing in the questions table are ignored.
+const recordAnswerQuery = `
+WITH u AS (
+	INSERT INTO users (telegram_user_id) VALUES ($1)
+	ON CONFLICT (telegram_user_id) DO UPDATE SET telegram_user_id = EXCLUDED.telegram_user_id
+	RETURNING id
+)
+INSERT INTO user_questions (user_id, question_id, seen_at, answered_at, is_correct)
+SELECT u.id, q.id, $3, $3, $4 FROM u, questions q WHERE q.id = $2
+ON CONFLICT (user_id, question_id) DO UPDATE
+	SET answered_at = EXCLUDED.answered_at, is_correct = EXCLUDED.is_correct
+	WHERE user_questions.answered_at IS NULL`
+
+const listStatsQuery = `
+SELECT q.language, q.topic, q.difficulty, count(*), count(*) FILTER (WHERE uq.is_correct)
+FROM user_questions uq
+JOIN users u ON u.id = uq.user_id
+JOIN questions q ON q.id = uq.question_id
+WHERE u.telegram_user_id = $1 AND uq.answered_at IS NOT NULL
+GROUP BY q.language, q.topic, q.difficulty`
+
+// Postgres keeps answers in the users and user_questions tables shared with
+// the content service.
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{
+		pool: pool,
+	}
+}
+
+// RecordAnswer keeps the first answer of the user to the question. Answers to
+// questions outside of the database, see questionUUID, are ignored.
+func (p *Postgres) RecordAnswer(ctx context.Context, answer *models.Answer) error {
+	questionID, ok := questionUUID(answer.QuestionID)
+	if !ok {
+		return nil
+	}
+
+	if _, err := p.pool.Exec(ctx, recordAnswerQuery, answer.UserID, questionID, answer.AnsweredAt, answer.Correct); err != nil {
+		return fmt.Errorf("failed to record answer: %w", err)
+	}
+
+	return nil
+}
+
+// questionUUID parses IDs of questions of the content service. Questions of
+// the question bank have other IDs and are not in the questions table.
+func questionUUID(id string) (pgtype.UUID, bool) {
+	var questionID pgtype.UUID
+	if err := questionID.Scan(id); err != nil {
+		return pgtype.UUID{}, false
+	}
+
+	return questionID, true
+}
+
+func (p *Postgres) ListStats(ctx context.Context, userID int64) ([]*models.Stats, error) {
+	rows, err := p.pool.Query(ctx, listStatsQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stats: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*models.Stats
+	for rows.Next() {
+		var (
+			language, topic, difficulty string
+			answered, correct           int64
+		)
+
+		if err = rows.Scan(&language, &topic, &difficulty, &answered, &correct); err != nil {
+			return nil, fmt.Errorf("failed to scan stats: %w", err)
+		}
+
+		list = append(list, &models.Stats{
+			Language:   quiz_models.Language(language),
+			Topic:      topic,
+			Difficulty: quiz_models.Difficulty(difficulty),
+			Answered:   uint32(answered),
+			Correct:    uint32(correct),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stats: %w", err)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,150 @@
+package profile
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/profile"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// testPostgresDSNEnv names the DSN of a migrated database to run the
+// repository tests against Postgres too.
+const testPostgresDSNEnv = "SNIPPET_WAR_TEST_POSTGRES_DSN"
+
+type repository interface {
+	RecordAnswer(ctx context.Context, answer *models.Answer) error
+	ListStats(ctx context.Context, userID int64) ([]*models.Stats, error)
+}
+
+type testQuestion struct {
+	id         string
+	topic      string
+	difficulty quiz_models.Difficulty
+}
+
+var testQuestions = []testQuestion{
+	{id: "7c0f3a52-5f0e-4d43-9a53-0d1c6b0a6e01", topic: "channels", difficulty: quiz_models.DifficultyBeginner},
+	{id: "7c0f3a52-5f0e-4d43-9a53-0d1c6b0a6e02", topic: "channels", difficulty: quiz_models.DifficultyBeginner},
+	{id: "7c0f3a52-5f0e-4d43-9a53-0d1c6b0a6e03", topic: "maps", difficulty: quiz_models.DifficultyAdvanced},
+}
+
+func TestMemory(t *testing.T) {
+	testRepository(t, NewMemory())
+}
+
+func TestPostgres(t *testing.T) {
+	dsn := os.Getenv(testPostgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testPostgresDSNEnv)
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	for _, question := range testQuestions {
+		_, err = pool.Exec(ctx, `
+INSERT INTO questions (id, language, topic, difficulty, question_type, code, question_text, correct_answers, explanation)
+VALUES ($1, 'go', $2, $3, 'free_text', '', '', '[]', '')
+ON CONFLICT (id) DO NOTHING`, question.id, question.topic, question.difficulty.String())
+		if err != nil {
+			t.Fatalf("insert question %s: %v", question.id, err)
+		}
+	}
+
+	t.Cleanup(func() {
+		ids := make([]string, 0, len(testQuestions))
+		for _, question := range testQuestions {
+			ids = append(ids, question.id)
+		}
+
+		if _, err := pool.Exec(ctx, `DELETE FROM questions WHERE id = ANY($1::uuid[])`, ids); err != nil {
+			t.Errorf("delete questions: %v", err)
+		}
+		if _, err := pool.Exec(ctx, `DELETE FROM users WHERE telegram_user_id = ANY($1)`, []int64{testUserID, testOtherUserID}); err != nil {
+			t.Errorf("delete users: %v", err)
+		}
+	})
+
+	testRepository(t, NewPostgres(pool))
+}
+
+const (
+	testUserID      int64 = 9_000_000_001
+	testOtherUserID int64 = 9_000_000_002
+)
+
+// testRepository checks behaviour both repositories share.
+func testRepository(t *testing.T, repo repository) {
+	ctx := context.Background()
+	answeredAt := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+
+	record := func(userID int64, questionID string, correct bool) {
+		t.Helper()
+
+		answer := &models.Answer{
+			UserID:     userID,
+			QuestionID: questionID,
+			Language:   quiz_models.LanguageGo,
+			Topic:      "channels",
+			Difficulty: quiz_models.DifficultyBeginner,
+			Correct:    correct,
+			AnsweredAt: answeredAt,
+		}
+		for _, question := range testQuestions {
+			if question.id == questionID {
+				answer.Topic = question.topic
+				answer.Difficulty = question.difficulty
+			}
+		}
+
+		if err := repo.RecordAnswer(ctx, answer); err != nil {
+			t.Fatalf("record answer to %s: %v", questionID, err)
+		}
+	}
+
+	record(testUserID, testQuestions[0].id, true)
+	// Only the first answer to a question counts.
+	record(testUserID, testQuestions[0].id, false)
+	record(testUserID, testQuestions[1].id, false)
+	record(testUserID, testQuestions[2].id, true)
+	// Answers to question bank questions are not recorded.
+	record(testUserID, "go:channels:beginner:1", true)
+	record(testOtherUserID, "go:channels:beginner:2", true)
+
+	stats, err := repo.ListStats(ctx, testUserID)
+	if err != nil {
+		t.Fatalf("list stats: %v", err)
+	}
+
+	slices.SortFunc(stats, func(a, b *models.Stats) int {
+		return strings.Compare(a.Topic, b.Topic)
+	})
+
+	want := []models.Stats{
+		{Language: quiz_models.LanguageGo, Topic: "channels", Difficulty: quiz_models.DifficultyBeginner, Answered: 2, Correct: 1},
+		{Language: quiz_models.LanguageGo, Topic: "maps", Difficulty: quiz_models.DifficultyAdvanced, Answered: 1, Correct: 1},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("got %d stats, want %d", len(stats), len(want))
+	}
+	for i := range want {
+		if *stats[i] != want[i] {
+			t.Errorf("got stats %+v, want %+v", *stats[i], want[i])
+		}
+	}
+
+	if stats, err = repo.ListStats(ctx, testOtherUserID); err != nil || len(stats) != 0 {
+		t.Errorf("got stats %v and error %v of a user with bank answers only, want none", stats, err)
+	}
+}
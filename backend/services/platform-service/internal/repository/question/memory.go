@@ -0,0 +1,61 @@
+package question
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type entry struct {
+	question  *models.Question
+	expiresAt time.Time
+}
+
+type Memory struct {
+	mu        sync.RWMutex
+	ttl       time.Duration
+	questions map[string]entry
+}
+
+func NewMemory(ttl time.Duration) *Memory {
+	return &Memory{
+		ttl:       ttl,
+		questions: make(map[string]entry),
+	}
+}
+
+func (m *Memory) Save(_ context.Context, questions []*models.Question) error {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, e := range m.questions {
+		if now.After(e.expiresAt) {
+			delete(m.questions, id)
+		}
+	}
+
+	for _, question := range questions {
+		m.questions[question.ID] = entry{
+			question:  question,
+			expiresAt: now.Add(m.ttl),
+		}
+	}
+
+	return nil
+}
+
+func (m *Memory) Get(_ context.Context, id string) (*models.Question, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	e, ok := m.questions[id]
+	if !ok || time.Now().After(e.expiresAt) {
+		return nil, models.ErrQuestionNotFound
+	}
+
+	return e.question, nil
+}
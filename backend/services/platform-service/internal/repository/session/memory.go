@@ -0,0 +1,85 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/session"
+)
+
+type entry struct {
+	session   *models.Session
+	expiresAt time.Time
+}
+
+// Memory keeps sessions for ttl after their last change, finished and
+// abandoned sessions are evicted alike.
+type Memory struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	sessions map[string]entry
+}
+
+func NewMemory(ttl time.Duration) *Memory {
+	return &Memory{
+		ttl:      ttl,
+		sessions: make(map[string]entry),
+	}
+}
+
+func (m *Memory) Create(_ context.Context, session *models.Session) error {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, e := range m.sessions {
+		if now.After(e.expiresAt) {
+			delete(m.sessions, id)
+		}
+	}
+
+	m.sessions[session.ID] = entry{
+		session:   session.Clone(),
+		expiresAt: now.Add(m.ttl),
+	}
+
+	return nil
+}
+
+func (m *Memory) Get(_ context.Context, id string) (*models.Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.sessions[id]
+	if !ok || time.Now().After(e.expiresAt) {
+		return nil, models.ErrSessionNotFound
+	}
+
+	return e.session.Clone(), nil
+}
+
+func (m *Memory) Update(_ context.Context, id string, update func(session *models.Session) error) (*models.Session, error) {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.sessions[id]
+	if !ok || now.After(e.expiresAt) {
+		return nil, models.ErrSessionNotFound
+	}
+
+	updated := e.session.Clone()
+	if err := update(updated); err != nil {
+		return nil, err
+	}
+
+	m.sessions[id] = entry{
+		session:   updated,
+		expiresAt: now.Add(m.ttl),
+	}
+
+	return updated.Clone(), nil
+}
@@ -0,0 +1,45 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+)
+
+// Header is the metadata key and HTTP header carrying the request ID.
+const Header = "x-request-id"
+
+const maxLength = 128
+
+type contextKey struct{}
+
+func New() string {
+	return rand.Text()
+}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Valid reports whether an ID received from a client is safe to propagate
+// and log as is.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
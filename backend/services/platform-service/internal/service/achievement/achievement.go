@@ -0,0 +1,196 @@
+package achievement
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/event"
+	models "github.com/casnerano/snippet-war/internal/model/achievement"
+	duel_models "github.com/casnerano/snippet-war/internal/model/duel"
+)
+
+type progressRepository interface {
+	ListProgress(ctx context.Context, userID int64) ([]*models.Progress, error)
+	UpdateProgress(ctx context.Context, userID int64, ruleID string, update func(progress *models.Progress) error) (*models.Progress, error)
+}
+
+type eventPublisher interface {
+	Publish(ctx context.Context, event event.Event)
+}
+
+type Achievement struct {
+	rules              *Rules
+	progressRepository progressRepository
+	eventPublisher     eventPublisher
+	now                func() time.Time
+}
+
+func New(rules *Rules, progressRepository progressRepository, eventPublisher eventPublisher) *Achievement {
+	return &Achievement{
+		rules:              rules,
+		progressRepository: progressRepository,
+		eventPublisher:     eventPublisher,
+		now:                time.Now,
+	}
+}
+
+func (a *Achievement) DefaultLocale() string {
+	return a.rules.DefaultLocale
+}
+
+// HandleEvent advances the progress of every rule matching the event and
+// publishes AchievementUnlocked for rules reaching their count. Unlocked
+// achievements are never revoked.
+func (a *Achievement) HandleEvent(ctx context.Context, e event.Event) {
+	for _, outcome := range outcomes(e) {
+		for _, rule := range a.rules.Rules {
+			if !rule.Matches(outcome) {
+				continue
+			}
+
+			if err := a.evaluate(ctx, rule, outcome); err != nil {
+				slog.ErrorContext(ctx, "failed evaluate achievement", "rule_id", rule.ID, "user_id", outcome.UserID, "error", err)
+			}
+		}
+	}
+}
+
+func (a *Achievement) evaluate(ctx context.Context, rule *models.Rule, outcome *models.Outcome) error {
+	var unlocked bool
+
+	progress, err := a.progressRepository.UpdateProgress(ctx, outcome.UserID, rule.ID, func(progress *models.Progress) error {
+		if progress.Unlocked() {
+			return nil
+		}
+
+		switch {
+		case rule.Counts(outcome):
+			progress.Count++
+		case rule.Consecutive:
+			progress.Count = 0
+		}
+
+		if progress.Count >= rule.Count {
+			unlockedAt := a.now()
+			progress.UnlockedAt = &unlockedAt
+			unlocked = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update progress: %w", err)
+	}
+
+	if unlocked {
+		a.eventPublisher.Publish(ctx, event.AchievementUnlocked{
+			UserID:      outcome.UserID,
+			DisplayName: outcome.DisplayName,
+			Achievement: achievementOf(rule, progress),
+			UnlockedAt:  *progress.UnlockedAt,
+		})
+	}
+
+	return nil
+}
+
+// ListAchievements returns every achievement with the progress of the user,
+// in the order of the rules.
+func (a *Achievement) ListAchievements(ctx context.Context) ([]*models.Achievement, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := a.progressRepository.ListProgress(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list progress: %w", err)
+	}
+
+	byRule := make(map[string]*models.Progress, len(progress))
+	for _, p := range progress {
+		byRule[p.RuleID] = p
+	}
+
+	achievements := make([]*models.Achievement, 0, len(a.rules.Rules))
+	for _, rule := range a.rules.Rules {
+		p, ok := byRule[rule.ID]
+		if !ok {
+			p = &models.Progress{UserID: user.ID, RuleID: rule.ID}
+		}
+
+		achievements = append(achievements, achievementOf(rule, p))
+	}
+
+	return achievements, nil
+}
+
+func achievementOf(rule *models.Rule, progress *models.Progress) *models.Achievement {
+	return &models.Achievement{
+		Rule:       rule,
+		Count:      min(progress.Count, rule.Count),
+		UnlockedAt: progress.UnlockedAt,
+	}
+}
+
+// outcomes converts a gameplay event to outcomes of its players.
+func outcomes(e event.Event) []*models.Outcome {
+	switch e := e.(type) {
+	case event.AnswerGraded:
+		return []*models.Outcome{{
+			UserID:      e.UserID,
+			DisplayName: e.DisplayName,
+			Event:       event.AnswerGradedName,
+			Language:    e.Question.Language,
+			Topics:      []string{e.Question.Topic},
+			Difficulty:  e.Question.Difficulty,
+			Success:     e.Result.Correct && !e.Result.Late,
+		}}
+	case event.SessionFinished:
+		return []*models.Outcome{{
+			UserID:      e.UserID,
+			DisplayName: e.DisplayName,
+			Event:       event.SessionFinishedName,
+			Language:    e.Session.Language,
+			Topics:      e.Session.Topics,
+			Difficulty:  e.Session.Difficulty,
+			Success:     e.Session.TotalQuestions > 0 && e.Session.CorrectAnswers == e.Session.TotalQuestions,
+		}}
+	case event.StreakExtended:
+		return []*models.Outcome{{
+			UserID:      e.UserID,
+			DisplayName: e.DisplayName,
+			Event:       event.StreakExtendedName,
+			Success:     true,
+			Streak:      e.Streak.Current,
+		}}
+	case event.DuelFinished:
+		if e.Duel.Status != duel_models.StatusFinished {
+			return nil
+		}
+
+		var outcomes []*models.Outcome
+		for _, player := range e.Duel.Players {
+			if player.Bot {
+				continue
+			}
+
+			outcomes = append(outcomes, &models.Outcome{
+				UserID:      player.UserID,
+				DisplayName: player.DisplayName,
+				Event:       event.DuelFinishedName,
+				Language:    e.Duel.Language,
+				Topics:      e.Duel.Topics,
+				Difficulty:  e.Duel.Difficulty,
+				Success:     e.Duel.WinnerID == player.UserID,
+			})
+		}
+
+		return outcomes
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package achievement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/event"
+	models "github.com/casnerano/snippet-war/internal/model/achievement"
+	duel_models "github.com/casnerano/snippet-war/internal/model/duel"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	achievement_repository "github.com/casnerano/snippet-war/internal/repository/achievement"
+)
+
+const testUserID = 42
+
+// unlocks records published AchievementUnlocked events, the event bus is
+// synchronous so no locking is needed.
+type unlocks []event.AchievementUnlocked
+
+func (u *unlocks) Publish(_ context.Context, e event.Event) {
+	if unlocked, ok := e.(event.AchievementUnlocked); ok {
+		*u = append(*u, unlocked)
+	}
+}
+
+func (u *unlocks) of(userID int64, ruleID string) []event.AchievementUnlocked {
+	var list []event.AchievementUnlocked
+	for _, unlocked := range *u {
+		if unlocked.UserID == userID && unlocked.Achievement.Rule.ID == ruleID {
+			list = append(list, unlocked)
+		}
+	}
+
+	return list
+}
+
+func newTestAchievement(rules ...*models.Rule) (*Achievement, *achievement_repository.Memory, *unlocks) {
+	repository := achievement_repository.NewMemory()
+	published := &unlocks{}
+
+	a := New(&Rules{DefaultLocale: "en", Rules: rules}, repository, published)
+	a.now = func() time.Time {
+		return time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	}
+
+	return a, repository, published
+}
+
+func answerGraded(topic string, correct, late bool) event.AnswerGraded {
+	return event.AnswerGraded{
+		UserID:      testUserID,
+		DisplayName: "Ada",
+		Question: &quiz_models.Question{
+			Language:   quiz_models.LanguageGo,
+			Topic:      topic,
+			Difficulty: quiz_models.DifficultyBeginner,
+		},
+		Result: &quiz_models.AnswerResult{Correct: correct, Late: late},
+	}
+}
+
+func progressOf(t *testing.T, repository *achievement_repository.Memory, userID int64, ruleID string) *models.Progress {
+	t.Helper()
+
+	list, err := repository.ListProgress(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("list progress: %v", err)
+	}
+
+	for _, progress := range list {
+		if progress.RuleID == ruleID {
+			return progress
+		}
+	}
+
+	return nil
+}
+
+func TestConsecutiveCountResets(t *testing.T) {
+	inARow := &models.Rule{
+		ID:          "channels_3_in_a_row",
+		Event:       event.AnswerGradedName,
+		Language:    quiz_models.LanguageGo,
+		Topic:       "channels",
+		Count:       3,
+		Consecutive: true,
+	}
+	total := &models.Rule{
+		ID:       "channels_3",
+		Event:    event.AnswerGradedName,
+		Language: quiz_models.LanguageGo,
+		Topic:    "channels",
+		Count:    3,
+	}
+	a, repository, published := newTestAchievement(inARow, total)
+	ctx := context.Background()
+
+	steps := []struct {
+		event event.AnswerGraded
+		// want is the count of the consecutive rule after the event.
+		want uint32
+	}{
+		{event: answerGraded("channels", true, false), want: 1},
+		{event: answerGraded("channels", true, false), want: 2},
+		{event: answerGraded("channels", false, false), want: 0},
+		{event: answerGraded("channels", true, false), want: 1},
+		// Answers out of scope neither count nor reset.
+		{event: answerGraded("maps", false, false), want: 1},
+		// A late correct answer is a failure.
+		{event: answerGraded("channels", true, true), want: 0},
+		{event: answerGraded("channels", true, false), want: 1},
+		{event: answerGraded("channels", true, false), want: 2},
+	}
+
+	for i, step := range steps {
+		a.HandleEvent(ctx, step.event)
+
+		if progress := progressOf(t, repository, testUserID, inARow.ID); progress.Count != step.want {
+			t.Errorf("after event %d: got count %d, want %d", i, progress.Count, step.want)
+		}
+	}
+
+	if got := published.of(testUserID, inARow.ID); len(got) != 0 {
+		t.Errorf("got %d unlocks of %s, want none", len(got), inARow.ID)
+	}
+
+	// The rule without Consecutive kept counting through failures.
+	if got := published.of(testUserID, total.ID); len(got) != 1 {
+		t.Errorf("got %d unlocks of %s, want 1", len(got), total.ID)
+	}
+
+	a.HandleEvent(ctx, answerGraded("channels", true, false))
+
+	if got := published.of(testUserID, inARow.ID); len(got) != 1 {
+		t.Errorf("got %d unlocks of %s after three correct answers in a row, want 1", len(got), inARow.ID)
+	}
+}
+
+func TestUnlockedAchievementIsNotRevoked(t *testing.T) {
+	rule := &models.Rule{
+		ID:          "two_in_a_row",
+		Event:       event.AnswerGradedName,
+		Count:       2,
+		Consecutive: true,
+	}
+	a, repository, published := newTestAchievement(rule)
+	ctx := context.Background()
+
+	a.HandleEvent(ctx, answerGraded("channels", true, false))
+	a.HandleEvent(ctx, answerGraded("channels", true, false))
+
+	unlocked := progressOf(t, repository, testUserID, rule.ID)
+	if !unlocked.Unlocked() {
+		t.Fatal("got achievement locked after two correct answers, want unlocked")
+	}
+
+	// Later events must neither reset nor unlock again.
+	a.now = func() time.Time {
+		return unlocked.UnlockedAt.Add(time.Hour)
+	}
+	a.HandleEvent(ctx, answerGraded("channels", false, false))
+	a.HandleEvent(ctx, answerGraded("channels", true, false))
+	a.HandleEvent(ctx, answerGraded("channels", true, false))
+
+	progress := progressOf(t, repository, testUserID, rule.ID)
+	if !progress.Unlocked() || !progress.UnlockedAt.Equal(*unlocked.UnlockedAt) || progress.Count != 2 {
+		t.Errorf("got progress %+v, want count 2 unlocked at %v", progress, unlocked.UnlockedAt)
+	}
+
+	if got := published.of(testUserID, rule.ID); len(got) != 1 {
+		t.Errorf("got %d unlocks, want 1", len(got))
+	}
+}
+
+func TestDuelFinishedSkipsBots(t *testing.T) {
+	won := &models.Rule{ID: "duel_won", Event: event.DuelFinishedName, Count: 1}
+	played := &models.Rule{ID: "duel_played", Event: event.DuelFinishedName, Count: 1, AnyOutcome: true}
+	a, repository, published := newTestAchievement(won, played)
+	ctx := context.Background()
+
+	const botID = -1
+
+	duel := func(status duel_models.Status, winnerID int64) event.DuelFinished {
+		return event.DuelFinished{Duel: &duel_models.Duel{
+			Status:     status,
+			Language:   quiz_models.LanguageGo,
+			Difficulty: quiz_models.DifficultyBeginner,
+			Players: []*duel_models.Player{
+				{UserID: testUserID, DisplayName: "Ada"},
+				{UserID: botID, DisplayName: "Snippet Bot", Bot: true},
+			},
+			WinnerID: winnerID,
+		}}
+	}
+
+	// Aborted duels are not outcomes.
+	a.HandleEvent(ctx, duel(duel_models.StatusAborted, 0))
+
+	if progress := progressOf(t, repository, testUserID, played.ID); progress != nil {
+		t.Errorf("got progress %+v after an aborted duel, want none", progress)
+	}
+
+	a.HandleEvent(ctx, duel(duel_models.StatusFinished, botID))
+
+	if got := published.of(testUserID, played.ID); len(got) != 1 {
+		t.Errorf("got %d unlocks of %s, want 1", len(got), played.ID)
+	}
+	if got := published.of(testUserID, won.ID); len(got) != 0 {
+		t.Errorf("got %d unlocks of %s for a lost duel, want none", len(got), won.ID)
+	}
+
+	a.HandleEvent(ctx, duel(duel_models.StatusFinished, testUserID))
+
+	if got := published.of(testUserID, won.ID); len(got) != 1 {
+		t.Errorf("got %d unlocks of %s for a won duel, want 1", len(got), won.ID)
+	}
+
+	list, err := repository.ListProgress(ctx, botID)
+	if err != nil {
+		t.Fatalf("list progress: %v", err)
+	}
+	if len(list) != 0 {
+		t.Errorf("got %d progress entries of the bot, want none", len(list))
+	}
+	for _, unlocked := range *published {
+		if unlocked.UserID == botID {
+			t.Errorf("got %s unlocked by the bot", unlocked.Achievement.Rule.ID)
+		}
+	}
+}
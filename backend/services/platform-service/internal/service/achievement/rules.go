@@ -0,0 +1,146 @@
+package achievement
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/casnerano/snippet-war/internal/event"
+	models "github.com/casnerano/snippet-war/internal/model/achievement"
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	"go.yaml.in/yaml/v3"
+)
+
+//go:embed achievements.json
+var defaultRulesData []byte
+
+var events = []string{
+	event.AnswerGradedName,
+	event.SessionFinishedName,
+	event.StreakExtendedName,
+	event.DuelFinishedName,
+}
+
+type rulesData struct {
+	DefaultLocale string `json:"default_locale" yaml:"default_locale"`
+	Achievements  []struct {
+		ID           string                 `json:"id" yaml:"id"`
+		Names        catalog_models.Names   `json:"names" yaml:"names"`
+		Descriptions catalog_models.Names   `json:"descriptions" yaml:"descriptions"`
+		Event        string                 `json:"event" yaml:"event"`
+		Language     quiz_models.Language   `json:"language" yaml:"language"`
+		Topic        string                 `json:"topic" yaml:"topic"`
+		Difficulty   quiz_models.Difficulty `json:"difficulty" yaml:"difficulty"`
+		Count        uint32                 `json:"count" yaml:"count"`
+		Consecutive  bool                   `json:"consecutive" yaml:"consecutive"`
+		AnyOutcome   bool                   `json:"any_outcome" yaml:"any_outcome"`
+		MinStreak    uint32                 `json:"min_streak" yaml:"min_streak"`
+	} `json:"achievements" yaml:"achievements"`
+}
+
+type Rules struct {
+	DefaultLocale string
+	Rules         []*models.Rule
+}
+
+// LoadRules reads achievement rules from a JSON or YAML file, an empty file
+// name loads the built-in rules.
+func LoadRules(fileName string) (*Rules, error) {
+	if fileName == "" {
+		return parseRules(defaultRulesData, json.Unmarshal)
+	}
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed read achievement rules %q: %w", fileName, err)
+	}
+
+	unmarshal := json.Unmarshal
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".yaml", ".yml":
+		unmarshal = yaml.Unmarshal
+	}
+
+	rules, err := parseRules(data, unmarshal)
+	if err != nil {
+		return nil, fmt.Errorf("failed load achievement rules %q: %w", fileName, err)
+	}
+
+	return rules, nil
+}
+
+func parseRules(data []byte, unmarshal func(data []byte, v any) error) (*Rules, error) {
+	var d rulesData
+	if err := unmarshal(data, &d); err != nil {
+		return nil, fmt.Errorf("failed to decode achievement rules: %w", err)
+	}
+
+	rules := &Rules{
+		DefaultLocale: d.DefaultLocale,
+		Rules:         make([]*models.Rule, 0, len(d.Achievements)),
+	}
+
+	ids := make(map[string]bool, len(d.Achievements))
+
+	for _, a := range d.Achievements {
+		rule := &models.Rule{
+			ID:           a.ID,
+			Names:        a.Names,
+			Descriptions: a.Descriptions,
+			Event:        a.Event,
+			Language:     a.Language,
+			Topic:        a.Topic,
+			Difficulty:   a.Difficulty,
+			Count:        a.Count,
+			Consecutive:  a.Consecutive,
+			AnyOutcome:   a.AnyOutcome,
+			MinStreak:    a.MinStreak,
+		}
+
+		if err := validateRule(rule, ids); err != nil {
+			return nil, err
+		}
+
+		ids[rule.ID] = true
+		rules.Rules = append(rules.Rules, rule)
+	}
+
+	return rules, nil
+}
+
+func validateRule(rule *models.Rule, ids map[string]bool) error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w %q: %s", models.ErrInvalidRule, rule.ID, fmt.Sprintf(format, args...))
+	}
+
+	switch {
+	case rule.ID == "":
+		return invalid("empty id")
+	case ids[rule.ID]:
+		return invalid("duplicate id")
+	case !slices.Contains(events, rule.Event):
+		return invalid("unknown event %q, expected one of %s", rule.Event, strings.Join(events, ", "))
+	case rule.Count == 0:
+		return invalid("count must be at least 1")
+	case rule.MinStreak > 0 && rule.Event != event.StreakExtendedName:
+		return invalid("min_streak requires the %s event", event.StreakExtendedName)
+	case rule.Event == event.StreakExtendedName && (rule.Language != "" || rule.Topic != "" || rule.Difficulty != ""):
+		return invalid("streaks count all languages, topics and difficulties")
+	}
+
+	switch rule.Difficulty {
+	case quiz_models.DifficultyUnspecified,
+		quiz_models.DifficultyBeginner,
+		quiz_models.DifficultyIntermediate,
+		quiz_models.DifficultyAdvanced:
+	default:
+		return invalid("unknown difficulty %q", rule.Difficulty)
+	}
+
+	return nil
+}
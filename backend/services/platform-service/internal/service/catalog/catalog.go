@@ -0,0 +1,113 @@
+package catalog
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	models "github.com/casnerano/snippet-war/internal/model/catalog"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+//go:embed catalog.json
+var catalogData []byte
+
+type data struct {
+	DefaultLocale string `json:"default_locale"`
+	Languages     []struct {
+		ID     quiz_models.Language `json:"id"`
+		Names  models.Names         `json:"names"`
+		Topics []struct {
+			ID           string                   `json:"id"`
+			Names        models.Names             `json:"names"`
+			Difficulties []quiz_models.Difficulty `json:"difficulties"`
+		} `json:"topics"`
+	} `json:"languages"`
+}
+
+type Catalog struct {
+	defaultLocale string
+	languages     []*models.Language
+}
+
+func New() (*Catalog, error) {
+	var d data
+	if err := json.Unmarshal(catalogData, &d); err != nil {
+		return nil, fmt.Errorf("failed to decode catalog: %w", err)
+	}
+
+	catalog := Catalog{
+		defaultLocale: d.DefaultLocale,
+		languages:     make([]*models.Language, 0, len(d.Languages)),
+	}
+
+	for _, l := range d.Languages {
+		language := models.Language{
+			ID:     l.ID,
+			Names:  l.Names,
+			Topics: make([]*models.Topic, 0, len(l.Topics)),
+		}
+
+		for _, t := range l.Topics {
+			language.Topics = append(language.Topics, &models.Topic{
+				ID:           t.ID,
+				Names:        t.Names,
+				Difficulties: t.Difficulties,
+			})
+		}
+
+		catalog.languages = append(catalog.languages, &language)
+	}
+
+	return &catalog, nil
+}
+
+func (c *Catalog) DefaultLocale() string {
+	return c.defaultLocale
+}
+
+func (c *Catalog) ListLanguages(_ context.Context) ([]*models.Language, error) {
+	return c.languages, nil
+}
+
+func (c *Catalog) ListTopics(_ context.Context, language quiz_models.Language) ([]*models.Topic, error) {
+	l, err := c.language(language)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.Topics, nil
+}
+
+// ValidateTopics checks that every topic exists for the language and is
+// offered at the difficulty.
+func (c *Catalog) ValidateTopics(_ context.Context, language quiz_models.Language, difficulty quiz_models.Difficulty, topics []string) error {
+	l, err := c.language(language)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range topics {
+		topic := l.Topic(id)
+		if topic == nil {
+			return fmt.Errorf("%w %q for language %q", models.ErrUnknownTopic, id, language)
+		}
+
+		if !topic.HasDifficulty(difficulty) {
+			return fmt.Errorf("%w: %q at %q", models.ErrTopicDifficulty, id, difficulty)
+		}
+	}
+
+	return nil
+}
+
+func (c *Catalog) language(id quiz_models.Language) (*models.Language, error) {
+	for _, language := range c.languages {
+		if language.ID == id {
+			return language, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %q", models.ErrUnknownLanguage, id)
+}
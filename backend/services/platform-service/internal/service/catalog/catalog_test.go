@@ -0,0 +1,60 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	models "github.com/casnerano/snippet-war/internal/model/catalog"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+func TestValidateTopics(t *testing.T) {
+	c, err := New()
+	if err != nil {
+		t.Fatalf("new catalog: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name       string
+		language   quiz_models.Language
+		difficulty quiz_models.Difficulty
+		topics     []string
+		want       error
+	}{
+		{
+			name:       "offered",
+			language:   quiz_models.LanguagePython,
+			difficulty: quiz_models.DifficultyAdvanced,
+			topics:     []string{"variables_types", "decorators"},
+		},
+		{
+			name:       "unknown language",
+			language:   quiz_models.Language("cobol"),
+			difficulty: quiz_models.DifficultyBeginner,
+			topics:     []string{"variables_types"},
+			want:       models.ErrUnknownLanguage,
+		},
+		{
+			name:       "unknown topic",
+			language:   quiz_models.LanguagePython,
+			difficulty: quiz_models.DifficultyBeginner,
+			topics:     []string{"variables_types", "monads"},
+			want:       models.ErrUnknownTopic,
+		},
+		{
+			name:       "not offered at difficulty",
+			language:   quiz_models.LanguagePython,
+			difficulty: quiz_models.DifficultyBeginner,
+			topics:     []string{"variables_types", "decorators"},
+			want:       models.ErrTopicDifficulty,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := c.ValidateTopics(context.Background(), tc.language, tc.difficulty, tc.topics)
+			if !errors.Is(err, tc.want) {
+				t.Errorf("got error %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+package daily
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/event"
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
+	models "github.com/casnerano/snippet-war/internal/model/daily"
+	leaderboard_models "github.com/casnerano/snippet-war/internal/model/leaderboard"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+)
+
+const defaultLimit = 10
+
+type questionSource interface {
+	ListQuestions(ctx context.Context, language quiz_models.Language) ([]*quiz_models.Question, error)
+}
+
+type topicProvider interface {
+	ListTopics(ctx context.Context, language quiz_models.Language) ([]*catalog_models.Topic, error)
+}
+
+type quizService interface {
+	IssueQuestions(ctx context.Context, args quiz_service.IssueQuestionsArgs) ([]*quiz_models.Question, error)
+	SubmitAnswer(ctx context.Context, args quiz_service.SubmitAnswerArgs) (*quiz_models.AnswerResult, error)
+}
+
+type challengeRepository interface {
+	GetChallenge(ctx context.Context, language quiz_models.Language, date time.Time) (*models.Challenge, error)
+	SaveChallenge(ctx context.Context, challenge *models.Challenge) (*models.Challenge, error)
+	GetAttempt(ctx context.Context, language quiz_models.Language, date time.Time, userID int64) (*models.Attempt, error)
+	CreateAttempt(ctx context.Context, attempt *models.Attempt) (*models.Attempt, error)
+	UpdateAttempt(ctx context.Context, language quiz_models.Language, date time.Time, userID int64, update func(attempt *models.Attempt) error) (*models.Attempt, error)
+	Top(ctx context.Context, language quiz_models.Language, date time.Time, limit uint32) ([]*leaderboard_models.Entry, error)
+	Rank(ctx context.Context, language quiz_models.Language, date time.Time, userID int64) (*leaderboard_models.Entry, error)
+	GetStreak(ctx context.Context, userID int64) (*models.Streak, error)
+	UpdateStreak(ctx context.Context, userID int64, update func(streak *models.Streak) error) (*models.Streak, error)
+}
+
+type eventPublisher interface {
+	Publish(ctx context.Context, event event.Event)
+}
+
+type Options struct {
+	// Seed makes challenges reproducible, the same seed and questions select
+	// the same challenge for a language and a day.
+	Seed uint64
+	// Difficulties of challenge questions in order, one question each.
+	Difficulties []quiz_models.Difficulty
+	// Now is the clock of challenge days and attempts, time.Now when nil.
+	Now func() time.Time
+}
+
+type Daily struct {
+	questionSource      questionSource
+	topicProvider       topicProvider
+	quizService         quizService
+	challengeRepository challengeRepository
+	eventPublisher      eventPublisher
+	options             Options
+	now                 func() time.Time
+
+	// locks keep a challenge from being selected twice by concurrent players,
+	// one per language.
+	mu    sync.Mutex
+	locks map[quiz_models.Language]*sync.Mutex
+}
+
+func New(
+	questionSource questionSource,
+	topicProvider topicProvider,
+	quizService quizService,
+	challengeRepository challengeRepository,
+	eventPublisher eventPublisher,
+	options Options,
+) *Daily {
+	now := options.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &Daily{
+		questionSource:      questionSource,
+		topicProvider:       topicProvider,
+		quizService:         quizService,
+		challengeRepository: challengeRepository,
+		eventPublisher:      eventPublisher,
+		options:             options,
+		now:                 now,
+		locks:               make(map[quiz_models.Language]*sync.Mutex),
+	}
+}
+
+// GetChallenge returns the attempt of the user at today's challenge, nil until
+// the attempt is started.
+func (d *Daily) GetChallenge(ctx context.Context, language quiz_models.Language) (*models.Progress, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	today := models.Day(d.now())
+
+	attempt, err := d.challengeRepository.GetAttempt(ctx, language, today, user.ID)
+	if err != nil && !errors.Is(err, models.ErrAttemptNotFound) {
+		return nil, fmt.Errorf("failed to get attempt: %w", err)
+	}
+
+	return d.progress(ctx, user, attempt, today)
+}
+
+// StartChallenge starts the attempt of the user at today's challenge, later
+// calls return the started attempt.
+func (d *Daily) StartChallenge(ctx context.Context, language quiz_models.Language) (*models.Progress, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := d.now()
+	today := models.Day(now)
+
+	attempt, err := d.challengeRepository.GetAttempt(ctx, language, today, user.ID)
+	if errors.Is(err, models.ErrAttemptNotFound) {
+		attempt, err = d.startAttempt(ctx, user, language, today, now)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to start attempt: %w", err)
+	}
+
+	return d.progress(ctx, user, attempt, today)
+}
+
+func (d *Daily) progress(ctx context.Context, user *auth.User, attempt *models.Attempt, today time.Time) (*models.Progress, error) {
+	streak, err := d.challengeRepository.GetStreak(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get streak: %w", err)
+	}
+
+	return &models.Progress{
+		Attempt: attempt,
+		Streak:  streak.Active(today),
+	}, nil
+}
+
+func (d *Daily) startAttempt(ctx context.Context, user *auth.User, language quiz_models.Language, today, now time.Time) (*models.Attempt, error) {
+	challenge, err := d.challenge(ctx, language, today)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := d.quizService.IssueQuestions(ctx, quiz_service.IssueQuestionsArgs{
+		UserID:    user.ID,
+		Questions: challenge.Questions,
+		Mode:      quiz_models.ModeDaily,
+		IssuedAt:  now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue questions: %w", err)
+	}
+
+	return d.challengeRepository.CreateAttempt(ctx, &models.Attempt{
+		UserID:      user.ID,
+		DisplayName: user.DisplayName(),
+		Date:        today,
+		Language:    language,
+		Questions:   questions,
+		StartedAt:   now,
+	})
+}
+
+// challenge returns the challenge of the day, selecting it on first use.
+func (d *Daily) challenge(ctx context.Context, language quiz_models.Language, date time.Time) (*models.Challenge, error) {
+	lock := d.lock(language)
+	lock.Lock()
+	defer lock.Unlock()
+
+	challenge, err := d.challengeRepository.GetChallenge(ctx, language, date)
+	if err == nil || !errors.Is(err, models.ErrChallengeNotFound) {
+		return challenge, err
+	}
+
+	questions, err := d.selectQuestions(ctx, language, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.challengeRepository.SaveChallenge(ctx, &models.Challenge{
+		Date:      date,
+		Language:  language,
+		Questions: questions,
+		CreatedAt: d.now(),
+	})
+}
+
+// selectQuestions picks a question of the question bank for every slot of the
+// seeded plan. Live questions differ from call to call, bank questions make
+// the challenge reproducible from the seed, also after a restart.
+func (d *Daily) selectQuestions(ctx context.Context, language quiz_models.Language, date time.Time) ([]*quiz_models.Question, error) {
+	topics, err := d.topicProvider.ListTopics(ctx, language)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := d.questionSource.ListQuestions(ctx, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list questions: %w", err)
+	}
+
+	questions := pick(newRand(d.options.Seed, language, date), topics, candidates, d.options.Difficulties)
+	if len(questions) == 0 {
+		return nil, models.ErrNoQuestions
+	}
+
+	return questions, nil
+}
+
+func (d *Daily) lock(language quiz_models.Language) *sync.Mutex {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	lock, ok := d.locks[language]
+	if !ok {
+		lock = &sync.Mutex{}
+		d.locks[language] = lock
+	}
+
+	return lock
+}
+
+// SubmitAnswer grades an answer to a question of today's attempt, every
+// question is answered once. Finishing the attempt extends the streak.
+func (d *Daily) SubmitAnswer(ctx context.Context, language quiz_models.Language, args quiz_service.SubmitAnswerArgs) (*models.Progress, *quiz_models.AnswerResult, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	today := models.Day(d.now())
+
+	_, err = d.challengeRepository.UpdateAttempt(ctx, language, today, user.ID, func(attempt *models.Attempt) error {
+		if err := checkCanAnswer(attempt, args.QuestionID); err != nil {
+			return err
+		}
+
+		attempt.GradingQuestionIDs = append(attempt.GradingQuestionIDs, args.QuestionID)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to claim question: %w", err)
+	}
+
+	args.Mode = quiz_models.ModeDaily
+
+	result, err := d.quizService.SubmitAnswer(ctx, args)
+	if err != nil {
+		d.releaseQuestion(ctx, language, today, user.ID, args.QuestionID)
+		return nil, nil, fmt.Errorf("failed to submit answer: %w", err)
+	}
+
+	attempt, err := d.challengeRepository.UpdateAttempt(ctx, language, today, user.ID, func(attempt *models.Attempt) error {
+		if !slices.Contains(attempt.GradingQuestionIDs, args.QuestionID) {
+			return models.ErrAlreadyAnswered
+		}
+
+		attempt.GradingQuestionIDs = slices.DeleteFunc(attempt.GradingQuestionIDs, func(id string) bool {
+			return id == args.QuestionID
+		})
+		attempt.Answers = append(attempt.Answers, &models.Answer{
+			QuestionID: args.QuestionID,
+			Correct:    result.Correct,
+			Late:       result.Late,
+			Points:     result.Points,
+		})
+		attempt.Score += result.Points
+
+		if result.Correct {
+			attempt.CorrectAnswers++
+		}
+
+		if len(attempt.Answers) == len(attempt.Questions) {
+			finishedAt := d.now()
+			attempt.FinishedAt = &finishedAt
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update attempt: %w", err)
+	}
+
+	streak, err := d.updateStreak(ctx, user, attempt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &models.Progress{
+		Attempt: attempt,
+		Streak:  streak.Active(today),
+	}, result, nil
+}
+
+// releaseQuestion lets the question be answered again after grading failed,
+// e.g. for an answer of the wrong type.
+func (d *Daily) releaseQuestion(ctx context.Context, language quiz_models.Language, date time.Time, userID int64, questionID string) {
+	_, err := d.challengeRepository.UpdateAttempt(ctx, language, date, userID, func(attempt *models.Attempt) error {
+		attempt.GradingQuestionIDs = slices.DeleteFunc(attempt.GradingQuestionIDs, func(id string) bool {
+			return id == questionID
+		})
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed release daily challenge question", "question_id", questionID, "error", err)
+	}
+}
+
+func (d *Daily) updateStreak(ctx context.Context, user *auth.User, attempt *models.Attempt) (*models.Streak, error) {
+	if attempt.FinishedAt == nil {
+		streak, err := d.challengeRepository.GetStreak(ctx, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get streak: %w", err)
+		}
+
+		return streak, nil
+	}
+
+	var extended bool
+
+	streak, err := d.challengeRepository.UpdateStreak(ctx, user.ID, func(streak *models.Streak) error {
+		extended = streak.Extend(attempt.Date)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update streak: %w", err)
+	}
+
+	if extended {
+		d.eventPublisher.Publish(ctx, event.StreakExtended{
+			UserID:      user.ID,
+			DisplayName: user.DisplayName(),
+			Streak:      streak,
+			ExtendedAt:  *attempt.FinishedAt,
+		})
+	}
+
+	return streak, nil
+}
+
+type GetLeaderboardArgs struct {
+	Language quiz_models.Language
+	// Date is any time of the day, today when zero.
+	Date  time.Time
+	Limit uint32
+}
+
+func (d *Daily) GetLeaderboard(ctx context.Context, args GetLeaderboardArgs) (time.Time, *leaderboard_models.Leaderboard, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	date := args.Date
+	if date.IsZero() {
+		date = d.now()
+	}
+	date = models.Day(date)
+
+	limit := args.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	entries, err := d.challengeRepository.Top(ctx, args.Language, date, limit)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to get top entries: %w", err)
+	}
+
+	me, err := d.challengeRepository.Rank(ctx, args.Language, date, user.ID)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to get user rank: %w", err)
+	}
+
+	return date, &leaderboard_models.Leaderboard{
+		Entries: entries,
+		Me:      me,
+	}, nil
+}
+
+func checkCanAnswer(attempt *models.Attempt, questionID string) error {
+	switch {
+	case attempt.FinishedAt != nil:
+		return models.ErrAttemptFinished
+	case !attempt.HasQuestion(questionID):
+		return models.ErrQuestionNotInSet
+	case attempt.Answer(questionID) != nil, slices.Contains(attempt.GradingQuestionIDs, questionID):
+		return models.ErrAlreadyAnswered
+	default:
+		return nil
+	}
+}
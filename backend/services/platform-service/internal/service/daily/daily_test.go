@@ -0,0 +1,295 @@
+package daily
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	question_bank "github.com/casnerano/snippet-war/internal/client/question_bank"
+	"github.com/casnerano/snippet-war/internal/event"
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
+	models "github.com/casnerano/snippet-war/internal/model/daily"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	daily_repository "github.com/casnerano/snippet-war/internal/repository/daily"
+	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+)
+
+type topics []*catalog_models.Topic
+
+func (t topics) ListTopics(_ context.Context, _ quiz_models.Language) ([]*catalog_models.Topic, error) {
+	return t, nil
+}
+
+// quizStub issues questions as they are and grades every answer correct.
+type quizStub struct {
+	mu     sync.Mutex
+	graded int
+}
+
+func (q *quizStub) IssueQuestions(_ context.Context, args quiz_service.IssueQuestionsArgs) ([]*quiz_models.Question, error) {
+	return args.Questions, nil
+}
+
+func (q *quizStub) SubmitAnswer(_ context.Context, args quiz_service.SubmitAnswerArgs) (*quiz_models.AnswerResult, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.graded++
+
+	return &quiz_models.AnswerResult{QuestionID: args.QuestionID, Correct: true, Points: 10}, nil
+}
+
+type events struct {
+	mu     sync.Mutex
+	events []event.Event
+}
+
+func (e *events) Publish(_ context.Context, event event.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.events = append(e.events, event)
+}
+
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+var testTopics = topics{
+	{ID: "channels", Difficulties: []quiz_models.Difficulty{quiz_models.DifficultyBeginner, quiz_models.DifficultyAdvanced}},
+	{ID: "maps", Difficulties: []quiz_models.Difficulty{quiz_models.DifficultyBeginner}},
+	{ID: "slices", Difficulties: []quiz_models.Difficulty{quiz_models.DifficultyBeginner, quiz_models.DifficultyAdvanced}},
+}
+
+func testBank() *question_bank.Bank {
+	var questions []*quiz_models.Question
+	for _, topic := range testTopics {
+		for _, difficulty := range topic.Difficulties {
+			for i := range 5 {
+				questions = append(questions, &quiz_models.Question{
+					ID:         fmt.Sprintf("%s:%s:%d", topic.ID, difficulty, i),
+					Language:   quiz_models.LanguageGo,
+					Topic:      topic.ID,
+					Difficulty: difficulty,
+					Answer:     &quiz_models.MultipleChoiceAnswer{Options: []string{"1", "2"}, CorrectOptions: []string{"2"}},
+				})
+			}
+		}
+	}
+
+	// Reversed, the bank orders questions itself.
+	slices.Reverse(questions)
+
+	return question_bank.New(questions)
+}
+
+func newTestDaily(seed uint64, c *clock) (*Daily, *quizStub, *events) {
+	quiz := &quizStub{}
+	published := &events{}
+
+	d := New(testBank(), testTopics, quiz, daily_repository.NewMemory(), published, Options{
+		Seed: seed,
+		Difficulties: []quiz_models.Difficulty{
+			quiz_models.DifficultyBeginner,
+			quiz_models.DifficultyBeginner,
+			quiz_models.DifficultyAdvanced,
+		},
+		Now: c.Now,
+	})
+
+	return d, quiz, published
+}
+
+func userContext(id int64) context.Context {
+	return auth.WithUser(context.Background(), &auth.User{ID: id, FirstName: "Player"})
+}
+
+func questionIDs(attempt *models.Attempt) []string {
+	ids := make([]string, 0, len(attempt.Questions))
+	for _, question := range attempt.Questions {
+		ids = append(ids, question.ID)
+	}
+
+	return ids
+}
+
+func TestStartChallengeIsReproducible(t *testing.T) {
+	c := &clock{now: time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)}
+
+	first, _, _ := newTestDaily(42, c)
+	// A fresh service stands for a restart.
+	second, _, _ := newTestDaily(42, c)
+
+	a, err := first.StartChallenge(userContext(1), quiz_models.LanguageGo)
+	if err != nil {
+		t.Fatalf("start challenge: %v", err)
+	}
+
+	b, err := second.StartChallenge(userContext(2), quiz_models.LanguageGo)
+	if err != nil {
+		t.Fatalf("start challenge after restart: %v", err)
+	}
+
+	if len(a.Attempt.Questions) != 3 {
+		t.Fatalf("got %d questions, want 3", len(a.Attempt.Questions))
+	}
+
+	if !slices.Equal(questionIDs(a.Attempt), questionIDs(b.Attempt)) {
+		t.Errorf("challenges differ after restart: %v and %v", questionIDs(a.Attempt), questionIDs(b.Attempt))
+	}
+
+	for i, difficulty := range []quiz_models.Difficulty{
+		quiz_models.DifficultyBeginner,
+		quiz_models.DifficultyBeginner,
+		quiz_models.DifficultyAdvanced,
+	} {
+		if got := a.Attempt.Questions[i].Difficulty; got != difficulty {
+			t.Errorf("question %d has difficulty %q, want %q", i, got, difficulty)
+		}
+	}
+}
+
+func TestGetChallengeDoesNotStartAttempt(t *testing.T) {
+	c := &clock{now: time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)}
+	d, _, _ := newTestDaily(42, c)
+	ctx := userContext(1)
+
+	progress, err := d.GetChallenge(ctx, quiz_models.LanguageGo)
+	if err != nil {
+		t.Fatalf("get challenge: %v", err)
+	}
+
+	if progress.Attempt != nil {
+		t.Fatalf("got an attempt before start")
+	}
+
+	started, err := d.StartChallenge(ctx, quiz_models.LanguageGo)
+	if err != nil {
+		t.Fatalf("start challenge: %v", err)
+	}
+
+	c.Add(time.Minute)
+
+	restarted, err := d.StartChallenge(ctx, quiz_models.LanguageGo)
+	if err != nil {
+		t.Fatalf("start challenge again: %v", err)
+	}
+
+	if !restarted.Attempt.StartedAt.Equal(started.Attempt.StartedAt) {
+		t.Errorf("second start moved the attempt start to %v", restarted.Attempt.StartedAt)
+	}
+}
+
+func TestSubmitAnswerGradesOnce(t *testing.T) {
+	c := &clock{now: time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)}
+	d, quiz, _ := newTestDaily(42, c)
+	ctx := userContext(1)
+
+	progress, err := d.StartChallenge(ctx, quiz_models.LanguageGo)
+	if err != nil {
+		t.Fatalf("start challenge: %v", err)
+	}
+
+	args := quiz_service.SubmitAnswerArgs{
+		QuestionID: progress.Attempt.Questions[0].ID,
+		Answer:     &quiz_models.MultipleChoiceUserAnswer{SelectedOptions: []string{"2"}},
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		accepted int
+	)
+	for range 10 {
+		wg.Go(func() {
+			_, _, err := d.SubmitAnswer(ctx, quiz_models.LanguageGo, args)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case err == nil:
+				accepted++
+			case !errors.Is(err, models.ErrAlreadyAnswered):
+				t.Errorf("submit answer: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if accepted != 1 || quiz.graded != 1 {
+		t.Errorf("accepted %d answers and graded %d, want 1 each", accepted, quiz.graded)
+	}
+}
+
+func TestFinishingAttemptsExtendsStreak(t *testing.T) {
+	c := &clock{now: time.Date(2026, 3, 14, 23, 0, 0, 0, time.UTC)}
+	d, _, published := newTestDaily(42, c)
+	ctx := userContext(1)
+
+	finish := func() *models.Progress {
+		t.Helper()
+
+		progress, err := d.StartChallenge(ctx, quiz_models.LanguageGo)
+		if err != nil {
+			t.Fatalf("start challenge: %v", err)
+		}
+
+		for _, question := range progress.Attempt.Questions {
+			progress, _, err = d.SubmitAnswer(ctx, quiz_models.LanguageGo, quiz_service.SubmitAnswerArgs{
+				QuestionID: question.ID,
+				Answer:     &quiz_models.MultipleChoiceUserAnswer{SelectedOptions: []string{"2"}},
+			})
+			if err != nil {
+				t.Fatalf("submit answer: %v", err)
+			}
+		}
+
+		return progress
+	}
+
+	if progress := finish(); progress.Attempt.FinishedAt == nil || progress.Streak.Current != 1 {
+		t.Fatalf("got streak %d after the first day, want 1", progress.Streak.Current)
+	}
+
+	c.Add(2 * time.Hour)
+
+	if progress := finish(); progress.Streak.Current != 2 {
+		t.Fatalf("got streak %d after the next day, want 2", progress.Streak.Current)
+	}
+
+	c.Add(48 * time.Hour)
+
+	progress, err := d.GetChallenge(ctx, quiz_models.LanguageGo)
+	if err != nil {
+		t.Fatalf("get challenge: %v", err)
+	}
+
+	if progress.Streak.Current != 0 || progress.Streak.Best != 2 {
+		t.Errorf("got streak %d, best %d after a missed day, want 0, best 2", progress.Streak.Current, progress.Streak.Best)
+	}
+
+	if len(published.events) != 2 {
+		t.Errorf("published %d streak events, want 2", len(published.events))
+	}
+}
@@ -0,0 +1,111 @@
+package daily
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type slot struct {
+	topic      string
+	difficulty quiz_models.Difficulty
+}
+
+// newRand seeds a generator for the language and the day, so the same seed
+// always gives the same challenge plan.
+func newRand(seed uint64, language quiz_models.Language, date time.Time) *rand.Rand {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(language.String() + ":" + date.Format(time.DateOnly)))
+
+	return rand.New(rand.NewPCG(seed, h.Sum64()))
+}
+
+// plan picks a topic for every difficulty slot of the challenge, topics are
+// not repeated while there are unused ones of the difficulty.
+func plan(r *rand.Rand, topics []*catalog_models.Topic, difficulties []quiz_models.Difficulty) []slot {
+	topics = slices.SortedFunc(slices.Values(topics), func(a, b *catalog_models.Topic) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	used := make(map[string]bool)
+	slots := make([]slot, 0, len(difficulties))
+
+	for _, difficulty := range difficulties {
+		var candidates, unused []string
+		for _, topic := range topics {
+			if !slices.Contains(topic.Difficulties, difficulty) {
+				continue
+			}
+
+			candidates = append(candidates, topic.ID)
+			if !used[topic.ID] {
+				unused = append(unused, topic.ID)
+			}
+		}
+
+		if len(unused) > 0 {
+			candidates = unused
+		}
+
+		if len(candidates) == 0 {
+			continue
+		}
+
+		topic := candidates[r.IntN(len(candidates))]
+		used[topic] = true
+
+		slots = append(slots, slot{
+			topic:      topic,
+			difficulty: difficulty,
+		})
+	}
+
+	return slots
+}
+
+// pick selects a question for every slot of the plan. Topics are planned
+// among those with candidate questions of the difficulty, candidates must be
+// in a stable order for the pick to be reproducible.
+func pick(r *rand.Rand, topics []*catalog_models.Topic, questions []*quiz_models.Question, difficulties []quiz_models.Difficulty) []*quiz_models.Question {
+	candidates := make(map[slot][]*quiz_models.Question)
+	for _, question := range questions {
+		s := slot{topic: question.Topic, difficulty: question.Difficulty}
+		candidates[s] = append(candidates[s], question)
+	}
+
+	available := make([]*catalog_models.Topic, 0, len(topics))
+	for _, topic := range topics {
+		var withQuestions []quiz_models.Difficulty
+		for _, difficulty := range topic.Difficulties {
+			if len(candidates[slot{topic: topic.ID, difficulty: difficulty}]) > 0 {
+				withQuestions = append(withQuestions, difficulty)
+			}
+		}
+
+		if len(withQuestions) > 0 {
+			available = append(available, &catalog_models.Topic{
+				ID:           topic.ID,
+				Difficulties: withQuestions,
+			})
+		}
+	}
+
+	picked := make([]*quiz_models.Question, 0, len(difficulties))
+	for _, s := range plan(r, available, difficulties) {
+		c := candidates[s]
+		if len(c) == 0 {
+			continue
+		}
+
+		idx := r.IntN(len(c))
+		picked = append(picked, c[idx])
+		candidates[s] = slices.Delete(slices.Clone(c), idx, idx+1)
+	}
+
+	return picked
+}
@@ -0,0 +1,58 @@
+package duel
+
+import (
+	"math/rand/v2"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/duel"
+)
+
+const botDisplayName = "Snippet Bot"
+
+type BotOptions struct {
+	MinDelay time.Duration
+	MaxDelay time.Duration
+	// Accuracy is the probability of a correct bot answer.
+	Accuracy float64
+}
+
+func (o BotOptions) delay() time.Duration {
+	if o.MaxDelay <= o.MinDelay {
+		return o.MinDelay
+	}
+
+	return o.MinDelay + rand.N(o.MaxDelay-o.MinDelay)
+}
+
+func (o BotOptions) correct() bool {
+	return rand.Float64() < o.Accuracy
+}
+
+func (d *Duel) newBot() *models.Player {
+	return &models.Player{
+		UserID:      -d.botIDs.Add(1),
+		DisplayName: botDisplayName,
+		Bot:         true,
+	}
+}
+
+// scheduleBotAnswers makes bots answer the question after a random delay. The
+// returned function cancels answers that are not given yet.
+func (d *Duel) scheduleBotAnswers(g *game, questionID string) func() {
+	var timers []*time.Timer
+
+	for _, bot := range g.bots() {
+		timers = append(timers, time.AfterFunc(d.options.Bot.delay(), func() {
+			answeredAt := time.Now()
+			if g.beginAnswer(bot.UserID, questionID, answeredAt) == nil {
+				_, _ = g.answer(bot.UserID, answeredAt, d.options.Bot.correct())
+			}
+		}))
+	}
+
+	return func() {
+		for _, timer := range timers {
+			timer.Stop()
+		}
+	}
+}
@@ -0,0 +1,389 @@
+package duel
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/event"
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
+	models "github.com/casnerano/snippet-war/internal/model/duel"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+)
+
+type quizService interface {
+	GetQuestions(ctx context.Context, args quiz_service.GetQuestionsArgs) ([]*quiz_models.Question, error)
+	IssueQuestions(ctx context.Context, args quiz_service.IssueQuestionsArgs) ([]*quiz_models.Question, error)
+	SubmitAnswer(ctx context.Context, args quiz_service.SubmitAnswerArgs) (*quiz_models.AnswerResult, error)
+}
+
+type topicProvider interface {
+	ListTopics(ctx context.Context, language quiz_models.Language) ([]*catalog_models.Topic, error)
+}
+
+type eventPublisher interface {
+	Publish(ctx context.Context, event event.Event)
+}
+
+type Options struct {
+	Rounds       uint32
+	RoundTimeout time.Duration
+	// BotTimeout is how long a player waits for an opponent before a bot
+	// takes the place, zero disables bots.
+	BotTimeout time.Duration
+	Bot        BotOptions
+}
+
+type Duel struct {
+	quizService    quizService
+	topicProvider  topicProvider
+	eventPublisher eventPublisher
+	options        Options
+
+	matchmaker *matchmaker
+	botIDs     atomic.Int64
+
+	mu    sync.Mutex
+	games map[string]*game
+
+	// ctx outlives requests of players, duels are aborted when it is done.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func New(quizService quizService, topicProvider topicProvider, eventPublisher eventPublisher, options Options) *Duel {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Duel{
+		quizService:    quizService,
+		topicProvider:  topicProvider,
+		eventPublisher: eventPublisher,
+		options:        options,
+		matchmaker:     newMatchmaker(),
+		games:          make(map[string]*game),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+}
+
+// Run aborts active duels once ctx is done.
+func (d *Duel) Run(ctx context.Context) error {
+	<-ctx.Done()
+	d.cancel()
+
+	return nil
+}
+
+type PlayArgs struct {
+	Language   quiz_models.Language
+	Difficulty quiz_models.Difficulty
+}
+
+// Play queues the user for an opponent and passes duel events to send until
+// the duel is finished. A player who disconnects stays in the duel and runs
+// out of time in the remaining rounds.
+func (d *Duel) Play(ctx context.Context, args PlayArgs, send func(event *models.Event) error) error {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	topics, err := d.topics(ctx, args.Language, args.Difficulty)
+	if err != nil {
+		return err
+	}
+
+	if err = send(&models.Event{Type: models.EventTypeWaiting}); err != nil {
+		return err
+	}
+
+	key := queueKey{
+		language:   args.Language,
+		difficulty: args.Difficulty,
+	}
+	t := newTicket(user, topics)
+
+	opponent, err := d.matchmaker.enqueue(key, t)
+	if err != nil {
+		return err
+	}
+
+	if opponent != nil {
+		d.startGame(key, opponent, t)
+	}
+
+	g, err := d.awaitGame(ctx, key, t)
+	if err != nil {
+		return err
+	}
+
+	events := g.events[user.ID]
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e := <-events:
+			if err = send(e); err != nil {
+				return err
+			}
+
+			if e.Type == models.EventTypeFinished {
+				return nil
+			}
+		}
+	}
+}
+
+func (d *Duel) awaitGame(ctx context.Context, key queueKey, t *ticket) (*game, error) {
+	var botTimeout <-chan time.Time
+	if d.options.BotTimeout > 0 {
+		timer := time.NewTimer(d.options.BotTimeout)
+		defer timer.Stop()
+
+		botTimeout = timer.C
+	}
+
+	for {
+		select {
+		case g := <-t.game:
+			return g, nil
+		case <-botTimeout:
+			if d.matchmaker.leave(key, t) {
+				d.startGame(key, t, nil)
+			}
+		case <-ctx.Done():
+			// A game delivered meanwhile goes on, the opponent plays alone.
+			d.matchmaker.leave(key, t)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// startGame starts a duel between two players, a nil opponent is replaced
+// by a bot.
+func (d *Duel) startGame(key queueKey, first, second *ticket) {
+	players := []*models.Player{playerFromUser(first.user)}
+	if second != nil {
+		players = append(players, playerFromUser(second.user))
+	} else {
+		players = append(players, d.newBot())
+	}
+
+	g := newGame(&models.Duel{
+		ID:          rand.Text(),
+		Status:      models.StatusActive,
+		Language:    key.language,
+		Topics:      first.topics,
+		Difficulty:  key.difficulty,
+		Players:     players,
+		TotalRounds: d.options.Rounds,
+		StartedAt:   time.Now(),
+	}, first.user)
+
+	d.mu.Lock()
+	d.games[g.id()] = g
+	d.mu.Unlock()
+
+	first.game <- g
+	if second != nil {
+		second.game <- g
+	}
+
+	go d.run(g)
+}
+
+func (d *Duel) run(g *game) {
+	defer func() {
+		d.mu.Lock()
+		delete(d.games, g.id())
+		d.mu.Unlock()
+	}()
+
+	ctx := auth.WithUser(d.ctx, g.owner)
+
+	g.publish(models.EventTypeMatched, false)
+
+	questions, err := d.quizService.GetQuestions(ctx, quiz_service.GetQuestionsArgs{
+		Language:   g.duel.Language,
+		Topics:     g.duel.Topics,
+		Difficulty: g.duel.Difficulty,
+		Limit:      g.duel.TotalRounds,
+		AnswerType: quiz_models.AnswerTypeMixed,
+		Mode:       quiz_models.ModeDuel,
+	})
+	if err == nil && len(questions) == 0 {
+		err = quiz_models.ErrQuestionNotFound
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get duel questions", "duel_id", g.id(), "error", err)
+		d.abort(g)
+		return
+	}
+
+	g.setTotalRounds(uint32(len(questions)))
+
+	for _, question := range questions {
+		if !d.playRound(ctx, g, question) {
+			d.abort(g)
+			return
+		}
+	}
+
+	finishedAt := time.Now()
+
+	g.finish(models.StatusFinished, finishedAt)
+	g.publish(models.EventTypeFinished, false)
+
+	d.eventPublisher.Publish(ctx, event.DuelFinished{
+		Duel:       g.snapshot(),
+		FinishedAt: finishedAt,
+	})
+}
+
+// playRound issues the question and waits until every player answers or the
+// round times out. It returns false when the duel must be aborted.
+func (d *Duel) playRound(ctx context.Context, g *game, question *quiz_models.Question) bool {
+	issuedAt := time.Now()
+	deadline := issuedAt.Add(d.options.RoundTimeout)
+
+	for _, userID := range g.humans() {
+		issued, err := d.quizService.IssueQuestions(ctx, quiz_service.IssueQuestionsArgs{
+			UserID:    userID,
+			Questions: []*quiz_models.Question{question},
+			Mode:      quiz_models.ModeDuel,
+			IssuedAt:  issuedAt,
+			Deadline:  deadline,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to issue duel question", "duel_id", g.id(), "error", err)
+			return false
+		}
+
+		question = issued[0]
+	}
+
+	g.startRound(question, issuedAt, deadline)
+	g.publish(models.EventTypeQuestion, true)
+
+	cancelBots := d.scheduleBotAnswers(g, question.ID)
+	defer cancelBots()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+wait:
+	for !g.allAnswered() {
+		select {
+		case <-g.answered:
+		case <-timer.C:
+			break wait
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	// Answers received in time may still be graded, the round waits for them.
+	g.closeRound()
+	for g.isGrading() {
+		select {
+		case <-g.answered:
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	g.finishRound()
+	g.publish(models.EventTypeRoundFinished, true)
+
+	return true
+}
+
+func (d *Duel) abort(g *game) {
+	g.finish(models.StatusAborted, time.Now())
+	g.publish(models.EventTypeFinished, false)
+}
+
+// SubmitAnswer grades an answer to the current question of the duel. The
+// answer time is taken when the request is received, before grading.
+func (d *Duel) SubmitAnswer(ctx context.Context, duelID string, args quiz_service.SubmitAnswerArgs) (*quiz_models.AnswerResult, *models.Answer, error) {
+	receivedAt := time.Now()
+
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g, err := d.game(duelID, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The round is checked before the issue of the question is consumed and
+	// stays open until the answer is recorded.
+	if err = g.beginAnswer(user.ID, args.QuestionID, receivedAt); err != nil {
+		return nil, nil, err
+	}
+
+	args.Mode = quiz_models.ModeDuel
+
+	result, err := d.quizService.SubmitAnswer(ctx, args)
+	if err != nil {
+		g.dropAnswer(user.ID)
+		return nil, nil, fmt.Errorf("failed to submit answer: %w", err)
+	}
+
+	answer, err := g.answer(user.ID, receivedAt, result.Correct)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, answer, nil
+}
+
+func (d *Duel) game(duelID string, userID int64) (*game, error) {
+	d.mu.Lock()
+	g, ok := d.games[duelID]
+	d.mu.Unlock()
+
+	if !ok || !g.isPlayer(userID) {
+		return nil, fmt.Errorf("failed to get duel %q: %w", duelID, models.ErrDuelNotFound)
+	}
+
+	return g, nil
+}
+
+// topics returns all topics of the language with questions of the difficulty.
+func (d *Duel) topics(ctx context.Context, language quiz_models.Language, difficulty quiz_models.Difficulty) ([]string, error) {
+	topics, err := d.topicProvider.ListTopics(ctx, language)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, topic := range topics {
+		if slices.Contains(topic.Difficulties, difficulty) {
+			ids = append(ids, topic.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, models.ErrNoTopics
+	}
+
+	return ids, nil
+}
+
+func playerFromUser(user *auth.User) *models.Player {
+	return &models.Player{
+		UserID:      user.ID,
+		DisplayName: user.DisplayName(),
+	}
+}
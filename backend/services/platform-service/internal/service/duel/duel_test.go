@@ -0,0 +1,319 @@
+package duel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/event"
+	catalog_models "github.com/casnerano/snippet-war/internal/model/catalog"
+	models "github.com/casnerano/snippet-war/internal/model/duel"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+)
+
+type topics []*catalog_models.Topic
+
+func (t topics) ListTopics(_ context.Context, _ quiz_models.Language) ([]*catalog_models.Topic, error) {
+	return t, nil
+}
+
+// quizFake serves numbered questions and grades every answer correct.
+type quizFake struct {
+	// release, if set, holds grading until it is closed.
+	release chan struct{}
+
+	mu     sync.Mutex
+	graded []string
+}
+
+func (q *quizFake) GetQuestions(_ context.Context, args quiz_service.GetQuestionsArgs) ([]*quiz_models.Question, error) {
+	questions := make([]*quiz_models.Question, 0, args.Limit)
+	for i := range args.Limit {
+		questions = append(questions, &quiz_models.Question{
+			ID:         fmt.Sprintf("q%d", i+1),
+			Language:   args.Language,
+			Difficulty: args.Difficulty,
+		})
+	}
+
+	return questions, nil
+}
+
+func (q *quizFake) IssueQuestions(_ context.Context, args quiz_service.IssueQuestionsArgs) ([]*quiz_models.Question, error) {
+	issued := make([]*quiz_models.Question, 0, len(args.Questions))
+	for _, question := range args.Questions {
+		clone := *question
+		clone.Deadline = args.Deadline
+		issued = append(issued, &clone)
+	}
+
+	return issued, nil
+}
+
+func (q *quizFake) SubmitAnswer(_ context.Context, args quiz_service.SubmitAnswerArgs) (*quiz_models.AnswerResult, error) {
+	if q.release != nil {
+		<-q.release
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.graded = append(q.graded, args.QuestionID)
+
+	return &quiz_models.AnswerResult{QuestionID: args.QuestionID, Correct: true, Points: 10}, nil
+}
+
+func (q *quizFake) gradedQuestions() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return append([]string(nil), q.graded...)
+}
+
+type events struct {
+	mu     sync.Mutex
+	events []event.Event
+}
+
+func (e *events) Publish(_ context.Context, event event.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.events = append(e.events, event)
+}
+
+func (e *events) published() []event.Event {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]event.Event(nil), e.events...)
+}
+
+var testTopics = topics{
+	{ID: "channels", Difficulties: []quiz_models.Difficulty{quiz_models.DifficultyBeginner}},
+}
+
+func newTestDuel(t *testing.T, quiz *quizFake, options Options) (*Duel, *events) {
+	t.Helper()
+
+	published := &events{}
+	d := New(quiz, testTopics, published, options)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = d.Run(ctx)
+	}()
+
+	return d, published
+}
+
+func userContext(id int64) context.Context {
+	return auth.WithUser(context.Background(), &auth.User{ID: id, FirstName: "Player"})
+}
+
+// play starts a duel of the user against a bot and returns its event stream.
+func play(t *testing.T, ctx context.Context, d *Duel) <-chan *models.Event {
+	t.Helper()
+
+	stream := make(chan *models.Event, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- d.Play(ctx, PlayArgs{
+			Language:   quiz_models.LanguageGo,
+			Difficulty: quiz_models.DifficultyBeginner,
+		}, func(e *models.Event) error {
+			stream <- e
+			return nil
+		})
+	}()
+
+	t.Cleanup(func() {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("play: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Error("play did not return")
+		}
+	})
+
+	return stream
+}
+
+func next(t *testing.T, stream <-chan *models.Event, want models.EventType) *models.Event {
+	t.Helper()
+
+	select {
+	case e := <-stream:
+		if e.Type != want {
+			t.Fatalf("got %q event, want %q", e.Type, want)
+		}
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no %q event", want)
+		return nil
+	}
+}
+
+func TestBotDuelIsPlayedToTheEnd(t *testing.T) {
+	quiz := &quizFake{}
+	d, published := newTestDuel(t, quiz, Options{
+		Rounds:       2,
+		RoundTimeout: 5 * time.Second,
+		BotTimeout:   10 * time.Millisecond,
+		// The bot answers wrong at once.
+		Bot: BotOptions{MinDelay: time.Millisecond, MaxDelay: time.Millisecond, Accuracy: 0},
+	})
+
+	ctx := userContext(42)
+	stream := play(t, ctx, d)
+
+	next(t, stream, models.EventTypeWaiting)
+	matched := next(t, stream, models.EventTypeMatched)
+
+	for range 2 {
+		question := next(t, stream, models.EventTypeQuestion)
+
+		result, answer, err := d.SubmitAnswer(ctx, matched.Duel.ID, quiz_service.SubmitAnswerArgs{
+			QuestionID: question.Round.Question.ID,
+		})
+		if err != nil {
+			t.Fatalf("submit answer to round %d: %v", question.Round.Number, err)
+		}
+		if !result.Correct || !answer.Answered || !answer.Correct {
+			t.Errorf("round %d: got result %+v and answer %+v, want a correct answer", question.Round.Number, result, answer)
+		}
+
+		round := next(t, stream, models.EventTypeRoundFinished).Round
+		if !round.Finished || len(round.Answers) != 2 {
+			t.Errorf("round %d: got %d answers, finished %v, want 2 answers of a finished round", round.Number, len(round.Answers), round.Finished)
+		}
+	}
+
+	finished := next(t, stream, models.EventTypeFinished).Duel
+	if finished.Status != models.StatusFinished || finished.WinnerID != 42 {
+		t.Errorf("got status %q and winner %d, want finished with winner 42", finished.Status, finished.WinnerID)
+	}
+
+	for _, player := range finished.Players {
+		want := uint32(2)
+		if player.Bot {
+			want = 0
+		}
+		if player.CorrectAnswers != want {
+			t.Errorf("player %d: got %d correct answers, want %d", player.UserID, player.CorrectAnswers, want)
+		}
+	}
+
+	if graded := quiz.gradedQuestions(); len(graded) != 2 {
+		t.Errorf("got graded questions %v, want answers of both rounds", graded)
+	}
+
+	var duelsFinished int
+	for _, e := range published.published() {
+		if e, ok := e.(event.DuelFinished); ok && e.Duel.ID == matched.Duel.ID {
+			duelsFinished++
+		}
+	}
+	if duelsFinished != 1 {
+		t.Errorf("got %d DuelFinished events, want 1", duelsFinished)
+	}
+}
+
+func TestLateAnswerIsNotGraded(t *testing.T) {
+	quiz := &quizFake{}
+	d, _ := newTestDuel(t, quiz, Options{
+		Rounds:       2,
+		RoundTimeout: 100 * time.Millisecond,
+		BotTimeout:   10 * time.Millisecond,
+		// The bot never answers in time.
+		Bot: BotOptions{MinDelay: time.Hour, MaxDelay: time.Hour},
+	})
+
+	ctx := userContext(42)
+	stream := play(t, ctx, d)
+
+	next(t, stream, models.EventTypeWaiting)
+	matched := next(t, stream, models.EventTypeMatched)
+	question := next(t, stream, models.EventTypeQuestion)
+	next(t, stream, models.EventTypeRoundFinished)
+
+	_, _, err := d.SubmitAnswer(ctx, matched.Duel.ID, quiz_service.SubmitAnswerArgs{
+		QuestionID: question.Round.Question.ID,
+	})
+	if !errors.Is(err, models.ErrQuestionNotCurrent) {
+		t.Errorf("got error %v for a late answer, want ErrQuestionNotCurrent", err)
+	}
+
+	if graded := quiz.gradedQuestions(); len(graded) != 0 {
+		t.Errorf("got graded questions %v, want a late answer not graded", graded)
+	}
+
+	next(t, stream, models.EventTypeQuestion)
+	next(t, stream, models.EventTypeRoundFinished)
+	next(t, stream, models.EventTypeFinished)
+}
+
+func TestAnswerGradedPastDeadlineIsRecorded(t *testing.T) {
+	quiz := &quizFake{release: make(chan struct{})}
+	d, _ := newTestDuel(t, quiz, Options{
+		Rounds:       1,
+		RoundTimeout: 100 * time.Millisecond,
+		BotTimeout:   10 * time.Millisecond,
+		Bot:          BotOptions{MinDelay: time.Hour, MaxDelay: time.Hour},
+	})
+
+	ctx := userContext(42)
+	stream := play(t, ctx, d)
+
+	next(t, stream, models.EventTypeWaiting)
+	matched := next(t, stream, models.EventTypeMatched)
+	question := next(t, stream, models.EventTypeQuestion)
+
+	type submitted struct {
+		answer *models.Answer
+		err    error
+	}
+	done := make(chan submitted, 1)
+	go func() {
+		_, answer, err := d.SubmitAnswer(ctx, matched.Duel.ID, quiz_service.SubmitAnswerArgs{
+			QuestionID: question.Round.Question.ID,
+		})
+		done <- submitted{answer: answer, err: err}
+	}()
+
+	// The answer is received in time, but grading outlasts the round.
+	time.Sleep(time.Until(question.Round.Deadline) + 50*time.Millisecond)
+
+	select {
+	case e := <-stream:
+		t.Fatalf("got %q event while the answer is graded, want the round open", e.Type)
+	default:
+	}
+
+	close(quiz.release)
+
+	s := <-done
+	if s.err != nil {
+		t.Fatalf("submit answer: %v", s.err)
+	}
+	if !s.answer.Correct {
+		t.Errorf("got answer %+v, want a correct answer", s.answer)
+	}
+
+	round := next(t, stream, models.EventTypeRoundFinished).Round
+	if answer := round.Answer(42); answer == nil || !answer.Answered || !answer.Correct {
+		t.Errorf("got answer %+v in the finished round, want the graded answer", answer)
+	}
+
+	if finished := next(t, stream, models.EventTypeFinished).Duel; finished.WinnerID != 42 {
+		t.Errorf("got winner %d, want 42", finished.WinnerID)
+	}
+}
@@ -0,0 +1,276 @@
+package duel
+
+import (
+	"sync"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	models "github.com/casnerano/snippet-war/internal/model/duel"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+// game is the runtime state of an active duel.
+type game struct {
+	mu   sync.Mutex
+	duel *models.Duel
+
+	// owner is the player questions are requested for.
+	owner *auth.User
+	// events are buffered for every event of the duel, so publishing never
+	// blocks on a slow or disconnected player.
+	events   map[int64]chan *models.Event
+	answered chan struct{}
+
+	// grading holds players whose answer to the current round is being
+	// graded, the round is not finished before their answers are recorded.
+	grading map[int64]struct{}
+	// closed is set when the current round takes no more answers.
+	closed bool
+}
+
+func newGame(duel *models.Duel, owner *auth.User) *game {
+	events := make(map[int64]chan *models.Event, len(duel.Players))
+	for _, player := range duel.Players {
+		if !player.Bot {
+			// Matched, question and result of every round, finished.
+			events[player.UserID] = make(chan *models.Event, 2*duel.TotalRounds+2)
+		}
+	}
+
+	return &game{
+		duel:     duel,
+		owner:    owner,
+		events:   events,
+		answered: make(chan struct{}, 1),
+		grading:  make(map[int64]struct{}),
+	}
+}
+
+func (g *game) id() string {
+	return g.duel.ID
+}
+
+func (g *game) isPlayer(userID int64) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.duel.Player(userID) != nil
+}
+
+func (g *game) snapshot() *models.Duel {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.duel.Clone()
+}
+
+func (g *game) humans() []int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var ids []int64
+	for _, player := range g.duel.Players {
+		if !player.Bot {
+			ids = append(ids, player.UserID)
+		}
+	}
+
+	return ids
+}
+
+func (g *game) bots() []*models.Player {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var bots []*models.Player
+	for _, player := range g.duel.Players {
+		if player.Bot {
+			bots = append(bots, player)
+		}
+	}
+
+	return bots
+}
+
+// publish sends a snapshot of the duel and of the last round, if withRound is
+// set, to every player.
+func (g *game) publish(eventType models.EventType, withRound bool) {
+	g.mu.Lock()
+
+	event := &models.Event{
+		Type: eventType,
+		Duel: g.duel.Clone(),
+	}
+
+	if withRound && len(g.duel.Rounds) > 0 {
+		event.Round = g.duel.Rounds[len(g.duel.Rounds)-1].Clone()
+	}
+
+	g.mu.Unlock()
+
+	for _, events := range g.events {
+		select {
+		case events <- event:
+		default:
+		}
+	}
+}
+
+func (g *game) setTotalRounds(total uint32) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.duel.TotalRounds = total
+}
+
+func (g *game) startRound(question *quiz_models.Question, issuedAt, deadline time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	round := &models.Round{
+		Number:   uint32(len(g.duel.Rounds)) + 1,
+		Question: question,
+		IssuedAt: issuedAt,
+		Deadline: deadline,
+	}
+	g.duel.Rounds = append(g.duel.Rounds, round)
+	g.closed = false
+}
+
+// beginAnswer accepts an answer of the user received at receivedAt for
+// grading. The round waits for the answer until it is recorded with answer or
+// dropped with dropAnswer.
+func (g *game) beginAnswer(userID int64, questionID string, receivedAt time.Time) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.duel.Status != models.StatusActive {
+		return models.ErrDuelFinished
+	}
+
+	round := g.duel.CurrentRound()
+	if round == nil || g.closed || round.Question.ID != questionID || receivedAt.After(round.Deadline) {
+		return models.ErrQuestionNotCurrent
+	}
+
+	if _, ok := g.grading[userID]; ok || round.Answer(userID) != nil {
+		return models.ErrAlreadyAnswered
+	}
+
+	g.grading[userID] = struct{}{}
+
+	return nil
+}
+
+// answer records the graded answer accepted by beginAnswer.
+func (g *game) answer(userID int64, receivedAt time.Time, correct bool) (*models.Answer, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.grading[userID]; !ok {
+		return nil, models.ErrQuestionNotCurrent
+	}
+	delete(g.grading, userID)
+
+	round := g.duel.CurrentRound()
+	answer := &models.Answer{
+		UserID:   userID,
+		Answered: true,
+		Correct:  correct,
+		Elapsed:  receivedAt.Sub(round.IssuedAt),
+	}
+	round.Answers = append(round.Answers, answer)
+
+	g.notifyAnswered()
+
+	clone := *answer
+
+	return &clone, nil
+}
+
+// dropAnswer releases the answer accepted by beginAnswer when it could not be
+// graded, the player may answer again.
+func (g *game) dropAnswer(userID int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.grading, userID)
+
+	g.notifyAnswered()
+}
+
+func (g *game) notifyAnswered() {
+	select {
+	case g.answered <- struct{}{}:
+	default:
+	}
+}
+
+// closeRound stops taking answers to the current round, answers being graded
+// are still recorded.
+func (g *game) closeRound() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.closed = true
+}
+
+func (g *game) isGrading() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return len(g.grading) > 0
+}
+
+func (g *game) allAnswered() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	round := g.duel.CurrentRound()
+
+	return round != nil && len(round.Answers) == len(g.duel.Players)
+}
+
+// finishRound closes the current round, players without an answer get the
+// full round time.
+func (g *game) finishRound() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	round := g.duel.CurrentRound()
+	if round == nil {
+		return
+	}
+
+	for _, player := range g.duel.Players {
+		if round.Answer(player.UserID) == nil {
+			round.Answers = append(round.Answers, &models.Answer{
+				UserID:  player.UserID,
+				Elapsed: round.Deadline.Sub(round.IssuedAt),
+			})
+		}
+	}
+
+	for _, answer := range round.Answers {
+		player := g.duel.Player(answer.UserID)
+		player.AnswerTime += answer.Elapsed
+
+		if answer.Correct {
+			player.CorrectAnswers++
+		}
+	}
+
+	round.Finished = true
+}
+
+func (g *game) finish(status models.Status, finishedAt time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.duel.Status = status
+	g.duel.FinishedAt = &finishedAt
+
+	if status == models.StatusFinished {
+		g.duel.DecideWinner()
+	}
+}
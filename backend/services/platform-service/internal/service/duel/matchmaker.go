@@ -0,0 +1,93 @@
+package duel
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	models "github.com/casnerano/snippet-war/internal/model/duel"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type queueKey struct {
+	language   quiz_models.Language
+	difficulty quiz_models.Difficulty
+}
+
+type ticket struct {
+	user   *auth.User
+	topics []string
+	game   chan *game
+}
+
+func newTicket(user *auth.User, topics []string) *ticket {
+	return &ticket{
+		user:   user,
+		topics: topics,
+		game:   make(chan *game, 1),
+	}
+}
+
+// matchmaker pairs waiting players with the same language and difficulty in
+// order of arrival.
+type matchmaker struct {
+	mu     sync.Mutex
+	queues map[queueKey][]*ticket
+}
+
+func newMatchmaker() *matchmaker {
+	return &matchmaker{
+		queues: make(map[queueKey][]*ticket),
+	}
+}
+
+// enqueue returns the longest waiting opponent for t or puts t in the queue
+// when there is none.
+func (m *matchmaker) enqueue(key queueKey, t *ticket) (*ticket, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	queue := m.queues[key]
+
+	for _, waiting := range queue {
+		if waiting.user.ID == t.user.ID {
+			return nil, models.ErrAlreadyQueued
+		}
+	}
+
+	if len(queue) == 0 {
+		m.queues[key] = []*ticket{t}
+		return nil, nil
+	}
+
+	m.setQueue(key, queue[1:])
+
+	return queue[0], nil
+}
+
+// leave removes t from the queue. It returns false when t is already matched
+// and its game is being delivered.
+func (m *matchmaker) leave(key queueKey, t *ticket) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	queue := m.queues[key]
+
+	idx := slices.Index(queue, t)
+	if idx < 0 {
+		return false
+	}
+
+	m.setQueue(key, slices.Delete(queue, idx, idx+1))
+
+	return true
+}
+
+func (m *matchmaker) setQueue(key queueKey, queue []*ticket) {
+	if len(queue) == 0 {
+		delete(m.queues, key)
+		return
+	}
+
+	m.queues[key] = queue
+}
@@ -0,0 +1,107 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/event"
+	models "github.com/casnerano/snippet-war/internal/model/leaderboard"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+const defaultLimit = 10
+
+type scoreRepository interface {
+	AddScore(ctx context.Context, score *models.Score) error
+	Top(ctx context.Context, filter models.Filter, limit uint32) ([]*models.Entry, error)
+	Rank(ctx context.Context, filter models.Filter, userID int64) (*models.Entry, error)
+}
+
+type Leaderboard struct {
+	scoreRepository scoreRepository
+	now             func() time.Time
+}
+
+func New(scoreRepository scoreRepository) *Leaderboard {
+	return &Leaderboard{
+		scoreRepository: scoreRepository,
+		now:             time.Now,
+	}
+}
+
+func (l *Leaderboard) HandleEvent(ctx context.Context, e event.Event) {
+	graded, ok := e.(event.AnswerGraded)
+	if !ok || graded.Result.Points == 0 {
+		return
+	}
+
+	err := l.scoreRepository.AddScore(ctx, &models.Score{
+		UserID:      graded.UserID,
+		DisplayName: graded.DisplayName,
+		Language:    graded.Question.Language,
+		Difficulty:  graded.Question.Difficulty,
+		Points:      graded.Result.Points,
+		ScoredAt:    graded.GradedAt,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed add leaderboard score", "user_id", graded.UserID, "error", err)
+	}
+}
+
+type GetLeaderboardArgs struct {
+	Period     models.Period
+	Language   quiz_models.Language
+	Difficulty quiz_models.Difficulty
+	Limit      uint32
+}
+
+func (l *Leaderboard) GetLeaderboard(ctx context.Context, args GetLeaderboardArgs) (*models.Leaderboard, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := models.Filter{
+		Since:      periodStart(args.Period, l.now()),
+		Language:   args.Language,
+		Difficulty: args.Difficulty,
+	}
+
+	limit := args.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	entries, err := l.scoreRepository.Top(ctx, filter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top entries: %w", err)
+	}
+
+	me, err := l.scoreRepository.Rank(ctx, filter, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user rank: %w", err)
+	}
+
+	return &models.Leaderboard{
+		Entries: entries,
+		Me:      me,
+	}, nil
+}
+
+func periodStart(period models.Period, now time.Time) time.Time {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch period {
+	case models.PeriodDaily:
+		return today
+	case models.PeriodWeekly:
+		daysSinceMonday := (int(today.Weekday()) + 6) % 7
+		return today.AddDate(0, 0, -daysSinceMonday)
+	default:
+		return time.Time{}
+	}
+}
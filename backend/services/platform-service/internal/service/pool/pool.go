@@ -0,0 +1,309 @@
+package pool
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/client/content_service"
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type contentProvider interface {
+	GetQuestions(ctx context.Context, tgUserID string, args content_service.GetQuestionsArgs) ([]*models.Question, error)
+}
+
+const (
+	defaultRefillInterval = 30 * time.Second
+	defaultSeenTTL        = 24 * time.Hour
+)
+
+type Options struct {
+	// Size is the number of questions kept per bucket. Zero disables the pool
+	// and every call goes straight to the content provider.
+	Size            int
+	RefillThreshold int
+	Concurrency     int
+	RefillInterval  time.Duration
+	// SeenTTL is how long a question served to a player is not served to
+	// them again from the pool.
+	SeenTTL time.Duration
+}
+
+type Key struct {
+	Language   models.Language
+	Topic      string
+	Difficulty models.Difficulty
+	AnswerType models.AnswerType
+}
+
+// Pool keeps pre-fetched questions per bucket in memory and serves them
+// instantly. Pooled questions are fetched without a user, so the content
+// service does not mark them as seen for the player who gets them. The pool
+// remembers questions served to every player instead and skips them for
+// SeenTTL.
+type Pool struct {
+	contentProvider contentProvider
+	options         Options
+	now             func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[Key][]*models.Question
+	refilling map[Key]bool
+	refills   chan Key
+	// seen maps a player to the expiry of every question served to them.
+	seen map[string]map[string]time.Time
+}
+
+func New(contentProvider contentProvider, options Options) *Pool {
+	if options.RefillInterval <= 0 {
+		options.RefillInterval = defaultRefillInterval
+	}
+	if options.SeenTTL <= 0 {
+		options.SeenTTL = defaultSeenTTL
+	}
+
+	return &Pool{
+		contentProvider: contentProvider,
+		options:         options,
+		now:             time.Now,
+		buckets:         make(map[Key][]*models.Question),
+		refilling:       make(map[Key]bool),
+		refills:         make(chan Key, 64),
+		seen:            make(map[string]map[string]time.Time),
+	}
+}
+
+func (p *Pool) GetQuestions(ctx context.Context, tgUserID string, args content_service.GetQuestionsArgs) ([]*models.Question, error) {
+	if p.options.Size <= 0 || len(args.Topics) == 0 {
+		return p.contentProvider.GetQuestions(ctx, tgUserID, args)
+	}
+
+	questions := make([]*models.Question, 0, args.Limit)
+	for idx, topic := range args.Topics {
+		count := topicCount(args.Limit, len(args.Topics), idx)
+		questions = append(questions, p.take(keyFor(args, topic), tgUserID, count)...)
+	}
+
+	if missing := args.Limit - uint32(len(questions)); missing > 0 {
+		liveArgs := args
+		liveArgs.Limit = missing
+
+		live, err := p.contentProvider.GetQuestions(ctx, tgUserID, liveArgs)
+		if err != nil {
+			if len(questions) > 0 {
+				slog.WarnContext(ctx, "failed get live questions, serving partial pool", "error", err)
+				return questions, nil
+			}
+			return nil, err
+		}
+
+		// Live questions may be pooled as well, they must not come back.
+		p.markSeen(tgUserID, live)
+		questions = append(questions, live...)
+	}
+
+	rand.Shuffle(len(questions), func(i, j int) {
+		questions[i], questions[j] = questions[j], questions[i]
+	})
+
+	return questions, nil
+}
+
+// Run refills buckets in the background until ctx is done.
+func (p *Pool) Run(ctx context.Context) error {
+	if p.options.Size <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, max(p.options.Concurrency, 1))
+		ticker    = time.NewTicker(p.options.RefillInterval)
+	)
+	defer ticker.Stop()
+
+	refill := func(key Key) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+				p.refill(ctx, key)
+			case <-ctx.Done():
+				p.finishRefill(key, nil)
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return nil
+		case key := <-p.refills:
+			refill(key)
+		case <-ticker.C:
+			for _, key := range p.lowBuckets() {
+				refill(key)
+			}
+			p.forgetSeen()
+		}
+	}
+}
+
+// take removes up to count questions the player has not seen from the
+// bucket and marks them as seen. Questions seen by the player stay for
+// others.
+func (p *Pool) take(key Key, tgUserID string, count uint32) []*models.Question {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	seen := p.seen[tgUserID]
+
+	var (
+		taken []*models.Question
+		rest  = make([]*models.Question, 0, len(p.buckets[key]))
+	)
+	for _, question := range p.buckets[key] {
+		if expiresAt, ok := seen[question.ID]; uint32(len(taken)) == count || (ok && now.Before(expiresAt)) {
+			rest = append(rest, question)
+			continue
+		}
+
+		taken = append(taken, question)
+	}
+	p.buckets[key] = rest
+
+	p.markSeenLocked(tgUserID, taken, now)
+
+	if len(p.buckets[key]) <= p.options.RefillThreshold && !p.refilling[key] {
+		select {
+		case p.refills <- key:
+			p.refilling[key] = true
+		default:
+		}
+	}
+
+	return taken
+}
+
+func (p *Pool) markSeen(tgUserID string, questions []*models.Question) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.markSeenLocked(tgUserID, questions, p.now())
+}
+
+func (p *Pool) markSeenLocked(tgUserID string, questions []*models.Question, now time.Time) {
+	if tgUserID == "" || len(questions) == 0 {
+		return
+	}
+
+	seen, ok := p.seen[tgUserID]
+	if !ok {
+		seen = make(map[string]time.Time, len(questions))
+		p.seen[tgUserID] = seen
+	}
+
+	for _, question := range questions {
+		seen[question.ID] = now.Add(p.options.SeenTTL)
+	}
+}
+
+// forgetSeen drops expired seen questions and players without any.
+func (p *Pool) forgetSeen() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	for tgUserID, seen := range p.seen {
+		for questionID, expiresAt := range seen {
+			if !now.Before(expiresAt) {
+				delete(seen, questionID)
+			}
+		}
+
+		if len(seen) == 0 {
+			delete(p.seen, tgUserID)
+		}
+	}
+}
+
+func (p *Pool) lowBuckets() []Key {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var keys []Key
+	for key, bucket := range p.buckets {
+		if len(bucket) <= p.options.RefillThreshold && !p.refilling[key] {
+			p.refilling[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func (p *Pool) refill(ctx context.Context, key Key) {
+	p.mu.Lock()
+	missing := p.options.Size - len(p.buckets[key])
+	p.mu.Unlock()
+
+	if missing <= 0 {
+		p.finishRefill(key, nil)
+		return
+	}
+
+	questions, err := p.contentProvider.GetQuestions(ctx, "", content_service.GetQuestionsArgs{
+		Language:   key.Language,
+		Topics:     []string{key.Topic},
+		Difficulty: key.Difficulty,
+		Limit:      uint32(missing),
+		AnswerType: key.AnswerType,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "failed refill question pool", "bucket", key, "error", err)
+	}
+
+	p.finishRefill(key, questions)
+}
+
+func (p *Pool) finishRefill(key Key, questions []*models.Question) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.buckets[key] = append(p.buckets[key], questions...)
+	p.refilling[key] = false
+}
+
+func keyFor(args content_service.GetQuestionsArgs, topic string) Key {
+	answerType := args.AnswerType
+	if answerType == models.AnswerTypeUnspecified {
+		answerType = models.AnswerTypeMultipleChoice
+	}
+
+	return Key{
+		Language:   args.Language,
+		Topic:      topic,
+		Difficulty: args.Difficulty,
+		AnswerType: answerType,
+	}
+}
+
+// topicCount distributes limit evenly across topics, giving the remainder
+// to the first ones, the same way the content service does.
+func topicCount(limit uint32, topics, idx int) uint32 {
+	count := limit / uint32(topics)
+	if uint32(idx) < limit%uint32(topics) {
+		count++
+	}
+
+	return count
+}
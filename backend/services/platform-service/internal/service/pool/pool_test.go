@@ -0,0 +1,108 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/client/content_service"
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+// provider returns new questions on every call.
+type provider struct {
+	mu   sync.Mutex
+	next int
+}
+
+func (p *provider) GetQuestions(_ context.Context, _ string, args content_service.GetQuestionsArgs) ([]*models.Question, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	questions := make([]*models.Question, 0, args.Limit)
+	for range args.Limit {
+		p.next++
+		questions = append(questions, &models.Question{ID: fmt.Sprintf("q%d", p.next), Topic: args.Topics[0]})
+	}
+
+	return questions, nil
+}
+
+func newTestPool(t *testing.T) (*Pool, *time.Time) {
+	t.Helper()
+
+	p := New(&provider{}, Options{Size: 4, SeenTTL: time.Hour})
+
+	now := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	p.now = func() time.Time {
+		return now
+	}
+
+	// One refill fills the bucket, the refill request queued by take is not
+	// processed without Run.
+	p.refill(context.Background(), Key{Language: models.LanguageGo, Topic: "maps", AnswerType: models.AnswerTypeMultipleChoice})
+
+	return p, &now
+}
+
+func getQuestionIDs(t *testing.T, p *Pool, tgUserID string, limit uint32) map[string]bool {
+	t.Helper()
+
+	questions, err := p.GetQuestions(context.Background(), tgUserID, content_service.GetQuestionsArgs{
+		Language: models.LanguageGo,
+		Topics:   []string{"maps"},
+		Limit:    limit,
+	})
+	if err != nil {
+		t.Fatalf("get questions: %v", err)
+	}
+
+	ids := make(map[string]bool, len(questions))
+	for _, question := range questions {
+		ids[question.ID] = true
+	}
+
+	return ids
+}
+
+func TestPoolSkipsSeenQuestions(t *testing.T) {
+	p, _ := newTestPool(t)
+
+	first := getQuestionIDs(t, p, "1", 2)
+
+	// Put the served questions back, as a refill returning them again would.
+	p.finishRefill(Key{Language: models.LanguageGo, Topic: "maps", AnswerType: models.AnswerTypeMultipleChoice}, []*models.Question{
+		{ID: "q1", Topic: "maps"},
+		{ID: "q2", Topic: "maps"},
+	})
+
+	second := getQuestionIDs(t, p, "1", 2)
+	for id := range second {
+		if first[id] {
+			t.Errorf("question %s was served to the same player twice", id)
+		}
+	}
+
+	other := getQuestionIDs(t, p, "2", 2)
+	if !other["q1"] || !other["q2"] {
+		t.Errorf("got %v for another player, want the questions the first player skipped", other)
+	}
+}
+
+func TestPoolForgetsSeenQuestions(t *testing.T) {
+	p, now := newTestPool(t)
+
+	getQuestionIDs(t, p, "1", 1)
+	if len(p.seen["1"]) != 1 {
+		t.Fatalf("got %d seen questions, want 1", len(p.seen["1"]))
+	}
+
+	*now = now.Add(time.Hour)
+	p.forgetSeen()
+
+	if _, ok := p.seen["1"]; ok {
+		t.Errorf("expired seen questions were kept")
+	}
+}
@@ -0,0 +1,45 @@
+package profile
+
+import quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+
+// levelStep is the XP needed for level 2, every next level needs one step
+// more than the previous one.
+const levelStep = 100
+
+// masteryPrior is added to weighted answers, so a few lucky answers do not
+// make a topic mastered.
+const masteryPrior = 5
+
+// weight of a question of the difficulty for XP and mastery, XP of a correct
+// answer matches base points.
+func weight(difficulty quiz_models.Difficulty) uint64 {
+	switch difficulty {
+	case quiz_models.DifficultyBeginner:
+		return 1
+	case quiz_models.DifficultyIntermediate:
+		return 2
+	case quiz_models.DifficultyAdvanced:
+		return 3
+	default:
+		return 0
+	}
+}
+
+func xp(difficulty quiz_models.Difficulty) uint64 {
+	return 10 * weight(difficulty)
+}
+
+// levelXP returns XP at which the level starts.
+func levelXP(level uint32) uint64 {
+	l := uint64(level)
+	return levelStep * l * (l - 1) / 2
+}
+
+func level(xp uint64) uint32 {
+	level := uint32(1)
+	for levelXP(level+1) <= xp {
+		level++
+	}
+
+	return level
+}
@@ -0,0 +1,126 @@
+package profile
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/event"
+	models "github.com/casnerano/snippet-war/internal/model/profile"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type profileRepository interface {
+	RecordAnswer(ctx context.Context, answer *models.Answer) error
+	ListStats(ctx context.Context, userID int64) ([]*models.Stats, error)
+}
+
+type Profile struct {
+	profileRepository profileRepository
+}
+
+func New(profileRepository profileRepository) *Profile {
+	return &Profile{
+		profileRepository: profileRepository,
+	}
+}
+
+// HandleEvent records graded answers, late answers count as wrong.
+func (p *Profile) HandleEvent(ctx context.Context, e event.Event) {
+	graded, ok := e.(event.AnswerGraded)
+	if !ok {
+		return
+	}
+
+	err := p.profileRepository.RecordAnswer(ctx, &models.Answer{
+		UserID:     graded.UserID,
+		QuestionID: graded.Question.ID,
+		Language:   graded.Question.Language,
+		Topic:      graded.Question.Topic,
+		Difficulty: graded.Question.Difficulty,
+		Correct:    graded.Result.Correct && !graded.Result.Late,
+		AnsweredAt: graded.GradedAt,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed record profile answer", "user_id", graded.UserID, "error", err)
+	}
+}
+
+func (p *Profile) GetProfile(ctx context.Context) (*models.Profile, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := p.profileRepository.ListStats(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stats: %w", err)
+	}
+
+	profile := &models.Profile{
+		UserID:      user.ID,
+		DisplayName: user.DisplayName(),
+	}
+
+	type topicKey struct {
+		language quiz_models.Language
+		topic    string
+	}
+
+	type topicWeights struct {
+		answered, correct uint64
+	}
+
+	mastery := make(map[topicKey]*models.Mastery)
+	weights := make(map[topicKey]*topicWeights)
+
+	for _, s := range stats {
+		profile.Answered += s.Answered
+		profile.Correct += s.Correct
+		profile.XP += xp(s.Difficulty) * uint64(s.Correct)
+
+		key := topicKey{language: s.Language, topic: s.Topic}
+
+		m, ok := mastery[key]
+		if !ok {
+			m = &models.Mastery{Language: s.Language, Topic: s.Topic}
+			mastery[key] = m
+			weights[key] = &topicWeights{}
+		}
+
+		m.Answered += s.Answered
+		m.Correct += s.Correct
+		weights[key].answered += weight(s.Difficulty) * uint64(s.Answered)
+		weights[key].correct += weight(s.Difficulty) * uint64(s.Correct)
+	}
+
+	if profile.Answered > 0 {
+		profile.Accuracy = float64(profile.Correct) / float64(profile.Answered)
+	}
+
+	profile.Level = level(profile.XP)
+	profile.LevelXP = levelXP(profile.Level)
+	profile.NextLevelXP = levelXP(profile.Level + 1)
+
+	for key, m := range mastery {
+		w := weights[key]
+		m.Score = uint32(100 * w.correct / (w.answered + masteryPrior))
+		profile.Mastery = append(profile.Mastery, m)
+	}
+
+	slices.SortFunc(profile.Mastery, func(a, b *models.Mastery) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Language.String(), b.Language.String()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Topic, b.Topic)
+	})
+
+	return profile, nil
+}
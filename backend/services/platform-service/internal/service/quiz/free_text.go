@@ -0,0 +1,99 @@
+package quiz
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+// fuzzyMinLength keeps short answers such as "nil" or "None" from matching
+// their neighbours within the tolerance. Numbers are never matched fuzzily,
+// see digits.
+const fuzzyMinLength = 6
+
+var quoteReplacer = strings.NewReplacer(
+	"“", `"`, "”", `"`, "„", `"`, "«", `"`, "»", `"`,
+	"‘", "'", "’", "'", "`", "'",
+)
+
+type freeTextGrader struct {
+	// fuzzyTolerance is the maximum edit distance accepted per language.
+	fuzzyTolerance map[models.Language]int
+}
+
+func (g *freeTextGrader) match(language models.Language, correctAnswers []string, given string) bool {
+	given = normalizeFreeText(given)
+	if given == "" {
+		return false
+	}
+
+	tolerance := g.fuzzyTolerance[language]
+
+	for _, correct := range correctAnswers {
+		correct = normalizeFreeText(correct)
+
+		if correct == given {
+			return true
+		}
+
+		if tolerance > 0 &&
+			utf8.RuneCountInString(correct) >= fuzzyMinLength &&
+			digits(correct) == digits(given) &&
+			levenshtein(correct, given) <= tolerance {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeFreeText(text string) string {
+	text = quoteReplacer.Replace(text)
+	text = strings.Join(strings.Fields(text), " ")
+
+	for len(text) >= 2 {
+		first, last := text[0], text[len(text)-1]
+		if first != last || (first != '"' && first != '\'') {
+			break
+		}
+		text = strings.TrimSpace(text[1 : len(text)-1])
+	}
+
+	return strings.ToLower(text)
+}
+
+func digits(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, text)
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,75 @@
+package quiz
+
+import (
+	"testing"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+func TestFreeTextMatch(t *testing.T) {
+	// Normalization is checked on Python, it has no fuzzy tolerance.
+	g := &freeTextGrader{
+		fuzzyTolerance: map[models.Language]int{
+			models.LanguageGo: 2,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		language models.Language
+		correct  []string
+		given    string
+		want     bool
+	}{
+		{name: "exact", language: models.LanguagePython, correct: []string{"[]int{1, 2}"}, given: "[]int{1, 2}", want: true},
+		{name: "any correct answer", language: models.LanguagePython, correct: []string{"panic", "runtime error"}, given: "runtime error", want: true},
+		{name: "empty", language: models.LanguagePython, correct: []string{"panic"}, given: "", want: false},
+		{name: "blank", language: models.LanguagePython, correct: []string{"panic"}, given: " \t\n", want: false},
+
+		{name: "surrounding double quotes", language: models.LanguagePython, correct: []string{"hello"}, given: `"hello"`, want: true},
+		{name: "surrounding typographic quotes", language: models.LanguagePython, correct: []string{"hello"}, given: "“hello”", want: true},
+		{name: "surrounding guillemets", language: models.LanguagePython, correct: []string{"hello"}, given: "«hello»", want: true},
+		{name: "surrounding backticks", language: models.LanguagePython, correct: []string{"nil"}, given: "`nil`", want: true},
+		{name: "nested quotes", language: models.LanguagePython, correct: []string{"hello"}, given: `"'hello'"`, want: true},
+		{name: "quotes of the correct answer", language: models.LanguagePython, correct: []string{`"hello"`}, given: "hello", want: true},
+		{name: "unbalanced quotes", language: models.LanguagePython, correct: []string{"nil"}, given: `"nil'`, want: false},
+		{name: "inner quotes kept", language: models.LanguagePython, correct: []string{`say "hi"`}, given: "say hi", want: false},
+		{name: "inner typographic quotes", language: models.LanguagePython, correct: []string{`say "hi"`}, given: "say “hi”", want: true},
+
+		{name: "surrounding whitespace", language: models.LanguagePython, correct: []string{"map[string]int"}, given: "  map[string]int\n", want: true},
+		{name: "inner whitespace collapsed", language: models.LanguagePython, correct: []string{"index out of range"}, given: "index \t out  of\nrange", want: true},
+		{name: "whitespace inside quotes", language: models.LanguagePython, correct: []string{"hello"}, given: `" hello "`, want: true},
+		{name: "missing space", language: models.LanguagePython, correct: []string{"[]int{1, 2}"}, given: "[]int{1,2}", want: false},
+
+		{name: "case", language: models.LanguagePython, correct: []string{"Hello World"}, given: "HELLO world", want: true},
+
+		{name: "one typo", language: models.LanguageGo, correct: []string{"goroutine"}, given: "gorotine", want: true},
+		{name: "two typos", language: models.LanguageGo, correct: []string{"goroutine"}, given: "gorutin", want: true},
+		{name: "three typos", language: models.LanguageGo, correct: []string{"goroutine"}, given: "grutin", want: false},
+		{name: "typo with normalization", language: models.LanguageGo, correct: []string{"deadlock"}, given: ` "DEADLOK" `, want: true},
+		{name: "no tolerance of the language", language: models.LanguagePython, correct: []string{"goroutine"}, given: "gorotine", want: false},
+
+		{name: "typo at minimum length", language: models.LanguageGo, correct: []string{"string"}, given: "strng", want: true},
+		{name: "typo below minimum length", language: models.LanguageGo, correct: []string{"slice"}, given: "slise", want: false},
+		{name: "short answer exact", language: models.LanguageGo, correct: []string{"nil"}, given: "NIL", want: true},
+		{name: "short answer neighbour", language: models.LanguageGo, correct: []string{"nil"}, given: "nul", want: false},
+		{name: "short answer prefix", language: models.LanguageGo, correct: []string{"true"}, given: "tru", want: false},
+		{name: "short number", language: models.LanguageGo, correct: []string{"42"}, given: "43", want: false},
+
+		{name: "typo next to matching number", language: models.LanguageGo, correct: []string{"output 42"}, given: "outpt 42", want: true},
+		{name: "changed digit", language: models.LanguageGo, correct: []string{"100000"}, given: "100001", want: false},
+		{name: "missing digit", language: models.LanguageGo, correct: []string{"1000000"}, given: "100000", want: false},
+		{name: "extra digit", language: models.LanguageGo, correct: []string{"[1 2 3]"}, given: "[1 2 3 4]", want: false},
+		{name: "swapped digits", language: models.LanguageGo, correct: []string{"[1 2 3]"}, given: "[1 3 2]", want: false},
+		{name: "changed digit in text", language: models.LanguageGo, correct: []string{"len is 10"}, given: "len is 11", want: false},
+		{name: "digits dropped", language: models.LanguageGo, correct: []string{"[]int{1}"}, given: "[]int{}", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.match(tt.language, tt.correct, tt.given); got != tt.want {
+				t.Errorf("match(%q, %q) = %v, want %v", tt.correct, tt.given, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package quiz
+
+import (
+	"slices"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type grader struct {
+	freeText *freeTextGrader
+	timing   *timing
+}
+
+func (g *grader) grade(question *models.Question, answer models.UserAnswer) (bool, error) {
+	switch expected := question.Answer.(type) {
+	case *models.MultipleChoiceAnswer:
+		given, ok := answer.(*models.MultipleChoiceUserAnswer)
+		if !ok {
+			return false, models.ErrAnswerTypeMismatch
+		}
+		return sameOptions(expected.CorrectOptions, given.SelectedOptions), nil
+	case *models.FreeTextAnswer:
+		given, ok := answer.(*models.FreeTextUserAnswer)
+		if !ok {
+			return false, models.ErrAnswerTypeMismatch
+		}
+		return g.freeText.match(question.Language, expected.CorrectAnswers, given.Text), nil
+	default:
+		return false, models.ErrAnswerTypeMismatch
+	}
+}
+
+func sameOptions(correct, selected []string) bool {
+	selected = slices.Compact(slices.Sorted(slices.Values(selected)))
+	if len(correct) != len(selected) {
+		return false
+	}
+
+	for _, option := range correct {
+		if !slices.Contains(selected, option) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func points(difficulty models.Difficulty) uint32 {
+	switch difficulty {
+	case models.DifficultyBeginner:
+		return 10
+	case models.DifficultyIntermediate:
+		return 20
+	case models.DifficultyAdvanced:
+		return 30
+	default:
+		return 0
+	}
+}
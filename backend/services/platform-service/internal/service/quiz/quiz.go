@@ -2,23 +2,97 @@ package quiz
 
 import (
 	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
 
+	"github.com/casnerano/snippet-war/internal/auth"
 	"github.com/casnerano/snippet-war/internal/client/content_service"
+	"github.com/casnerano/snippet-war/internal/event"
+	"github.com/casnerano/snippet-war/internal/metrics"
 	models "github.com/casnerano/snippet-war/internal/model/quiz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/casnerano/snippet-war/internal/service/quiz")
+
 type contentProvider interface {
 	GetQuestions(ctx context.Context, tgUserID string, args content_service.GetQuestionsArgs) ([]*models.Question, error)
 }
 
+type questionRepository interface {
+	Save(ctx context.Context, questions []*models.Question) error
+	Get(ctx context.Context, id string) (*models.Question, error)
+}
+
+type issueRepository interface {
+	Save(ctx context.Context, issues []*models.Issue) error
+	Consume(ctx context.Context, userID int64, questionID string, mode models.Mode, answeredAt time.Time) (*models.Issue, error)
+}
+
+type topicValidator interface {
+	ValidateTopics(ctx context.Context, language models.Language, difficulty models.Difficulty, topics []string) error
+}
+
+type eventPublisher interface {
+	Publish(ctx context.Context, event event.Event)
+}
+
+type Options struct {
+	// FuzzyTolerance is the maximum edit distance between a free-text answer
+	// and a correct one per language. Languages without a value match exactly.
+	FuzzyTolerance map[models.Language]int
+	// TimeLimits to answer a question per difficulty, difficulties without a
+	// value have no limit.
+	TimeLimits map[models.Difficulty]time.Duration
+	// SpeedBonus is the share of points added for an instant correct answer,
+	// it decreases linearly to zero at the deadline.
+	SpeedBonus float64
+}
+
 type Quiz struct {
-	contentProvider contentProvider
+	contentProvider    contentProvider
+	questionRepository questionRepository
+	issueRepository    issueRepository
+	topicValidator     topicValidator
+	eventPublisher     eventPublisher
+	grader             atomic.Pointer[grader]
 }
 
-func New(contentProvider contentProvider) *Quiz {
-	return &Quiz{
-		contentProvider: contentProvider,
+func New(
+	contentProvider contentProvider,
+	questionRepository questionRepository,
+	issueRepository issueRepository,
+	topicValidator topicValidator,
+	eventPublisher eventPublisher,
+	options Options,
+) *Quiz {
+	quiz := &Quiz{
+		contentProvider:    contentProvider,
+		questionRepository: questionRepository,
+		issueRepository:    issueRepository,
+		topicValidator:     topicValidator,
+		eventPublisher:     eventPublisher,
 	}
+	quiz.SetOptions(options)
+
+	return quiz
+}
+
+// SetOptions replaces grading settings for answers submitted from now on.
+func (q *Quiz) SetOptions(options Options) {
+	q.grader.Store(&grader{
+		freeText: &freeTextGrader{
+			fuzzyTolerance: options.FuzzyTolerance,
+		},
+		timing: &timing{
+			timeLimits: options.TimeLimits,
+			speedBonus: options.SpeedBonus,
+		},
+	})
 }
 
 type GetQuestionsArgs struct {
@@ -26,15 +100,198 @@ type GetQuestionsArgs struct {
 	Topics     []string
 	Difficulty models.Difficulty
 	Limit      uint32
+	AnswerType models.AnswerType
+	Mode       models.Mode
 }
 
-func (q *Quiz) GetQuestions(ctx context.Context, args GetQuestionsArgs) ([]*models.Question, error) {
-	tgUserID := "1"
+func (q *Quiz) GetQuestions(ctx context.Context, args GetQuestionsArgs) (_ []*models.Question, err error) {
+	ctx, span := tracer.Start(ctx, "quiz.GetQuestions", trace.WithAttributes(
+		attribute.String("quiz.language", args.Language.String()),
+		attribute.String("quiz.difficulty", args.Difficulty.String()),
+		attribute.StringSlice("quiz.topics", args.Topics),
+		attribute.Int("quiz.limit", int(args.Limit)),
+	))
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	return q.contentProvider.GetQuestions(ctx, tgUserID, content_service.GetQuestionsArgs{
+	if err = q.topicValidator.ValidateTopics(ctx, args.Language, args.Difficulty, args.Topics); err != nil {
+		return nil, err
+	}
+
+	questions, err := q.contentProvider.GetQuestions(ctx, user.TelegramID(), content_service.GetQuestionsArgs{
 		Language:   args.Language,
 		Topics:     args.Topics,
 		Difficulty: args.Difficulty,
 		Limit:      args.Limit,
+		AnswerType: args.AnswerType,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = q.questionRepository.Save(ctx, questions); err != nil {
+		return nil, fmt.Errorf("failed to save questions: %w", err)
+	}
+
+	issuedAt := time.Now()
+	deadlines := q.grader.Load().timing.deadlines(questions, issuedAt)
+
+	issues := make([]*models.Issue, 0, len(questions))
+	served := make([]*models.Question, 0, len(questions))
+	for i, question := range questions {
+		issues = append(issues, &models.Issue{
+			UserID:     user.ID,
+			QuestionID: question.ID,
+			Mode:       args.Mode,
+			IssuedAt:   issuedAt,
+			Deadline:   deadlines[i],
+		})
+
+		clone := *question
+		clone.Deadline = deadlines[i]
+		served = append(served, &clone)
+	}
+
+	if err = q.issueRepository.Save(ctx, issues); err != nil {
+		return nil, fmt.Errorf("failed to save issues: %w", err)
+	}
+
+	metrics.QuestionsServed(questions)
+
+	return served, nil
+}
+
+type IssueQuestionsArgs struct {
+	UserID    int64
+	Questions []*models.Question
+	Mode      models.Mode
+	IssuedAt  time.Time
+	// Deadline overrides time limits of question difficulties.
+	Deadline time.Time
+}
+
+// IssueQuestions serves questions fetched earlier to a user, e.g. a duel
+// opponent or a daily challenge player. Returned copies carry deadlines.
+func (q *Quiz) IssueQuestions(ctx context.Context, args IssueQuestionsArgs) ([]*models.Question, error) {
+	deadlines := q.grader.Load().timing.deadlines(args.Questions, args.IssuedAt)
+
+	stored := make([]*models.Question, 0, len(args.Questions))
+	issues := make([]*models.Issue, 0, len(args.Questions))
+	issued := make([]*models.Question, 0, len(args.Questions))
+	for i, question := range args.Questions {
+		deadline := deadlines[i]
+		if !args.Deadline.IsZero() {
+			deadline = args.Deadline
+		}
+
+		original := *question
+		original.Deadline = time.Time{}
+		stored = append(stored, &original)
+
+		issues = append(issues, &models.Issue{
+			UserID:     args.UserID,
+			QuestionID: question.ID,
+			Mode:       args.Mode,
+			IssuedAt:   args.IssuedAt,
+			Deadline:   deadline,
+		})
+
+		clone := original
+		clone.Deadline = deadline
+		issued = append(issued, &clone)
+	}
+
+	// Saving again keeps questions of long running games from expiring.
+	if err := q.questionRepository.Save(ctx, stored); err != nil {
+		return nil, fmt.Errorf("failed to save questions: %w", err)
+	}
+
+	if err := q.issueRepository.Save(ctx, issues); err != nil {
+		return nil, fmt.Errorf("failed to save issues: %w", err)
+	}
+
+	return issued, nil
+}
+
+type SubmitAnswerArgs struct {
+	QuestionID string
+	Answer     models.UserAnswer
+	// Mode the question was issued for.
+	Mode models.Mode
+}
+
+// SubmitAnswer grades the answer, every issued question is graded once.
+// Answers received after the deadline of the question are graded without
+// points.
+func (q *Quiz) SubmitAnswer(ctx context.Context, args SubmitAnswerArgs) (_ *models.AnswerResult, err error) {
+	receivedAt := time.Now()
+
+	ctx, span := tracer.Start(ctx, "quiz.SubmitAnswer", trace.WithAttributes(
+		attribute.String("quiz.question_id", args.QuestionID),
+	))
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	question, err := q.questionRepository.Get(ctx, args.QuestionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question %q: %w", args.QuestionID, err)
+	}
+
+	g := q.grader.Load()
+
+	// Answers of the wrong type are rejected before the issue is consumed.
+	correct, err := g.grade(question, args.Answer)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := q.issueRepository.Consume(ctx, user.ID, args.QuestionID, args.Mode, receivedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume issue of question %q: %w", args.QuestionID, err)
+	}
+
+	result := models.AnswerResult{
+		QuestionID:     question.ID,
+		Correct:        correct,
+		CorrectAnswers: question.CorrectAnswers(),
+		Explanation:    question.Explanation,
+		Late:           issue.Late(receivedAt),
+	}
+
+	metrics.AnswerGraded(question, correct)
+
+	if correct && !result.Late {
+		result.SpeedBonus = g.timing.bonus(question, issue, receivedAt)
+		result.Points = points(question.Difficulty) + result.SpeedBonus
+	}
+
+	q.eventPublisher.Publish(ctx, event.AnswerGraded{
+		UserID:      user.ID,
+		DisplayName: user.DisplayName(),
+		Question:    question,
+		Result:      &result,
+		GradedAt:    time.Now(),
+	})
+
+	return &result, nil
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
 }
@@ -0,0 +1,54 @@
+package quiz
+
+import (
+	"math"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+type timing struct {
+	// timeLimits per difficulty, difficulties without a value have no limit.
+	timeLimits map[models.Difficulty]time.Duration
+	// speedBonus is the share of base points awarded for answering instantly,
+	// it decreases linearly to zero at the deadline.
+	speedBonus float64
+}
+
+// deadlines returns deadlines of questions served together. Every question
+// gets the limit of its difficulty from issuedAt, limits of a batch do not add
+// up.
+func (t *timing) deadlines(questions []*models.Question, issuedAt time.Time) []time.Time {
+	deadlines := make([]time.Time, 0, len(questions))
+
+	for _, question := range questions {
+		limit := t.timeLimits[question.Difficulty]
+		if limit <= 0 {
+			deadlines = append(deadlines, time.Time{})
+			continue
+		}
+
+		deadlines = append(deadlines, issuedAt.Add(limit))
+	}
+
+	return deadlines
+}
+
+// bonus returns speed bonus points for a correct answer in time. Time left
+// beyond the limit of the question, e.g. on a shared duel round deadline,
+// does not add to the bonus.
+func (t *timing) bonus(question *models.Question, issue *models.Issue, answeredAt time.Time) uint32 {
+	if issue.Deadline.IsZero() || t.speedBonus <= 0 {
+		return 0
+	}
+
+	limit := t.timeLimits[question.Difficulty]
+	remaining := issue.Deadline.Sub(answeredAt)
+	if limit <= 0 || remaining <= 0 {
+		return 0
+	}
+
+	ratio := min(float64(remaining)/float64(limit), 1)
+
+	return uint32(math.Round(float64(points(question.Difficulty)) * t.speedBonus * ratio))
+}
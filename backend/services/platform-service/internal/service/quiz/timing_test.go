@@ -0,0 +1,76 @@
+package quiz
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/casnerano/snippet-war/internal/model/quiz"
+)
+
+func TestDeadlinesOfBatchDoNotAddUp(t *testing.T) {
+	tm := &timing{
+		timeLimits: map[models.Difficulty]time.Duration{
+			models.DifficultyBeginner:     30 * time.Second,
+			models.DifficultyIntermediate: time.Minute,
+		},
+	}
+
+	issuedAt := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	questions := []*models.Question{
+		{ID: "1", Difficulty: models.DifficultyIntermediate},
+		{ID: "2", Difficulty: models.DifficultyBeginner},
+		{ID: "3", Difficulty: models.DifficultyAdvanced},
+		{ID: "4", Difficulty: models.DifficultyBeginner},
+	}
+
+	want := []time.Time{
+		issuedAt.Add(time.Minute),
+		issuedAt.Add(30 * time.Second),
+		{},
+		issuedAt.Add(30 * time.Second),
+	}
+
+	got := tm.deadlines(questions, issuedAt)
+	if len(got) != len(want) {
+		t.Fatalf("got %d deadlines, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("question %s: got deadline %v, want %v", questions[i].ID, got[i], want[i])
+		}
+	}
+}
+
+func TestBonusOfLastQuestionInBatch(t *testing.T) {
+	tm := &timing{
+		timeLimits: map[models.Difficulty]time.Duration{
+			models.DifficultyBeginner: 30 * time.Second,
+		},
+		speedBonus: 0.5,
+	}
+
+	issuedAt := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	questions := []*models.Question{
+		{ID: "1", Difficulty: models.DifficultyBeginner},
+		{ID: "2", Difficulty: models.DifficultyBeginner},
+		{ID: "3", Difficulty: models.DifficultyBeginner},
+	}
+	deadlines := tm.deadlines(questions, issuedAt)
+
+	// The last question answered after the limit of one question is late, it
+	// earns no bonus.
+	issue := &models.Issue{QuestionID: "3", IssuedAt: issuedAt, Deadline: deadlines[2]}
+	answeredAt := issuedAt.Add(45 * time.Second)
+	if !issue.Late(answeredAt) {
+		t.Errorf("answer after %s is not late, want late", answeredAt.Sub(issuedAt))
+	}
+	if bonus := tm.bonus(questions[2], issue, answeredAt); bonus != 0 {
+		t.Errorf("got bonus %d for a late answer, want 0", bonus)
+	}
+
+	// Answering instantly earns the full bonus, half of 10 points.
+	if bonus := tm.bonus(questions[2], issue, issuedAt); bonus != 5 {
+		t.Errorf("got bonus %d for an instant answer, want 5", bonus)
+	}
+}
@@ -0,0 +1,289 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/casnerano/snippet-war/internal/auth"
+	"github.com/casnerano/snippet-war/internal/event"
+	quiz_models "github.com/casnerano/snippet-war/internal/model/quiz"
+	models "github.com/casnerano/snippet-war/internal/model/session"
+	quiz_service "github.com/casnerano/snippet-war/internal/service/quiz"
+)
+
+type quizService interface {
+	GetQuestions(ctx context.Context, args quiz_service.GetQuestionsArgs) ([]*quiz_models.Question, error)
+	SubmitAnswer(ctx context.Context, args quiz_service.SubmitAnswerArgs) (*quiz_models.AnswerResult, error)
+}
+
+type topicValidator interface {
+	ValidateTopics(ctx context.Context, language quiz_models.Language, difficulty quiz_models.Difficulty, topics []string) error
+}
+
+type sessionRepository interface {
+	Create(ctx context.Context, session *models.Session) error
+	Get(ctx context.Context, id string) (*models.Session, error)
+	Update(ctx context.Context, id string, update func(session *models.Session) error) (*models.Session, error)
+}
+
+type eventPublisher interface {
+	Publish(ctx context.Context, event event.Event)
+}
+
+type Session struct {
+	quizService       quizService
+	topicValidator    topicValidator
+	sessionRepository sessionRepository
+	eventPublisher    eventPublisher
+}
+
+func New(
+	quizService quizService,
+	topicValidator topicValidator,
+	sessionRepository sessionRepository,
+	eventPublisher eventPublisher,
+) *Session {
+	return &Session{
+		quizService:       quizService,
+		topicValidator:    topicValidator,
+		sessionRepository: sessionRepository,
+		eventPublisher:    eventPublisher,
+	}
+}
+
+type StartSessionArgs struct {
+	Language       quiz_models.Language
+	Topics         []string
+	Difficulty     quiz_models.Difficulty
+	AnswerType     quiz_models.AnswerType
+	TotalQuestions uint32
+}
+
+func (s *Session) StartSession(ctx context.Context, args StartSessionArgs) (*models.Session, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.topicValidator.ValidateTopics(ctx, args.Language, args.Difficulty, args.Topics); err != nil {
+		return nil, err
+	}
+
+	session := models.Session{
+		ID:             rand.Text(),
+		UserID:         user.ID,
+		Status:         models.StatusActive,
+		Language:       args.Language,
+		Topics:         args.Topics,
+		Difficulty:     args.Difficulty,
+		AnswerType:     args.AnswerType,
+		TotalQuestions: args.TotalQuestions,
+		StartedAt:      time.Now(),
+	}
+
+	if err = s.sessionRepository.Create(ctx, &session); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return &session, nil
+}
+
+func (s *Session) NextQuestion(ctx context.Context, sessionID string) (*models.Session, *quiz_models.Question, error) {
+	session, err := s.getActiveSession(ctx, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = checkCanServeQuestion(session); err != nil {
+		return nil, nil, err
+	}
+
+	questions, err := s.quizService.GetQuestions(ctx, quiz_service.GetQuestionsArgs{
+		Language:   session.Language,
+		Topics:     session.Topics,
+		Difficulty: session.Difficulty,
+		Limit:      1,
+		AnswerType: session.AnswerType,
+		Mode:       quiz_models.ModeSession,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get question: %w", err)
+	}
+
+	if len(questions) == 0 {
+		return nil, nil, models.ErrNoQuestionsLeft
+	}
+
+	question := questions[0]
+
+	session, err = s.sessionRepository.Update(ctx, sessionID, func(session *models.Session) error {
+		if err := checkCanServeQuestion(session); err != nil {
+			return err
+		}
+
+		session.CurrentQuestionID = question.ID
+		session.ServedQuestionIDs = append(session.ServedQuestionIDs, question.ID)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return session, question, nil
+}
+
+// SubmitAnswer claims the current question before grading, so concurrent
+// answers to the same question are graded once.
+func (s *Session) SubmitAnswer(ctx context.Context, sessionID string, args quiz_service.SubmitAnswerArgs) (*models.Session, *quiz_models.AnswerResult, error) {
+	if _, err := s.getActiveSession(ctx, sessionID); err != nil {
+		return nil, nil, err
+	}
+
+	_, err := s.sessionRepository.Update(ctx, sessionID, func(session *models.Session) error {
+		if err := checkCanAnswer(session, args.QuestionID); err != nil {
+			return err
+		}
+
+		session.GradingQuestionID = args.QuestionID
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to claim question: %w", err)
+	}
+
+	args.Mode = quiz_models.ModeSession
+
+	result, err := s.quizService.SubmitAnswer(ctx, args)
+	if err != nil {
+		s.releaseQuestion(ctx, sessionID, args.QuestionID)
+		return nil, nil, fmt.Errorf("failed to submit answer: %w", err)
+	}
+
+	session, err := s.sessionRepository.Update(ctx, sessionID, func(session *models.Session) error {
+		if session.GradingQuestionID != args.QuestionID {
+			return models.ErrQuestionNotCurrent
+		}
+
+		session.CurrentQuestionID = ""
+		session.GradingQuestionID = ""
+		session.AnsweredQuestions++
+		session.Score += result.Points
+
+		if result.Correct {
+			session.CorrectAnswers++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return session, result, nil
+}
+
+// releaseQuestion lets the question be answered again after grading failed,
+// e.g. for an answer of the wrong type.
+func (s *Session) releaseQuestion(ctx context.Context, sessionID, questionID string) {
+	_, err := s.sessionRepository.Update(ctx, sessionID, func(session *models.Session) error {
+		if session.GradingQuestionID == questionID {
+			session.GradingQuestionID = ""
+		}
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed release session question", "session_id", sessionID, "error", err)
+	}
+}
+
+func (s *Session) FinishSession(ctx context.Context, sessionID string) (*models.Session, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.getActiveSession(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	session, err := s.sessionRepository.Update(ctx, sessionID, func(session *models.Session) error {
+		switch {
+		case session.Status != models.StatusActive:
+			return models.ErrSessionFinished
+		case session.GradingQuestionID != "":
+			return models.ErrAnswerPending
+		}
+
+		finishedAt := time.Now()
+
+		session.Status = models.StatusFinished
+		session.CurrentQuestionID = ""
+		session.FinishedAt = &finishedAt
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	s.eventPublisher.Publish(ctx, event.SessionFinished{
+		UserID:      user.ID,
+		DisplayName: user.DisplayName(),
+		Session:     session.Clone(),
+		FinishedAt:  *session.FinishedAt,
+	})
+
+	return session, nil
+}
+
+func (s *Session) getActiveSession(ctx context.Context, sessionID string) (*models.Session, error) {
+	user, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.sessionRepository.Get(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session %q: %w", sessionID, err)
+	}
+
+	if session.UserID != user.ID {
+		return nil, fmt.Errorf("failed to get session %q: %w", sessionID, models.ErrSessionNotFound)
+	}
+
+	if session.Status != models.StatusActive {
+		return nil, models.ErrSessionFinished
+	}
+
+	return session, nil
+}
+
+func checkCanAnswer(session *models.Session, questionID string) error {
+	switch {
+	case session.Status != models.StatusActive:
+		return models.ErrSessionFinished
+	case session.CurrentQuestionID != questionID, session.GradingQuestionID != "":
+		return models.ErrQuestionNotCurrent
+	default:
+		return nil
+	}
+}
+
+func checkCanServeQuestion(session *models.Session) error {
+	switch {
+	case session.Status != models.StatusActive:
+		return models.ErrSessionFinished
+	case session.CurrentQuestionID != "":
+		return models.ErrAnswerPending
+	case session.RemainingQuestions() == 0:
+		return models.ErrNoQuestionsLeft
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+type Options struct {
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64
+	ServiceName string
+}
+
+// Setup installs the global tracer provider and W3C trace context propagator.
+// The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, options Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+
+	switch options.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		exporterOptions := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(options.Endpoint),
+		}
+		if options.Insecure {
+			exporterOptions = append(exporterOptions, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err = otlptracegrpc.New(ctx, exporterOptions...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", options.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s exporter: %w", options.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(options.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
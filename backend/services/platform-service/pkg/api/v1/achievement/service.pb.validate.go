@@ -0,0 +1,514 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/v1/achievement/service.proto
+
+package achievement
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListAchievements with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListAchievements) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAchievements with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAchievementsMultiError, or nil if none found.
+func (m *ListAchievements) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAchievements) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListAchievementsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAchievementsMultiError is an error wrapping multiple validation errors
+// returned by ListAchievements.ValidateAll() if the designated constraints
+// aren't met.
+type ListAchievementsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAchievementsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAchievementsMultiError) AllErrors() []error { return m }
+
+// ListAchievementsValidationError is the validation error returned by
+// ListAchievements.Validate if the designated constraints aren't met.
+type ListAchievementsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAchievementsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAchievementsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAchievementsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAchievementsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAchievementsValidationError) ErrorName() string { return "ListAchievementsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListAchievementsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAchievements.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAchievementsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAchievementsValidationError{}
+
+// Validate checks the field values on Achievement with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Achievement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Achievement with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AchievementMultiError, or
+// nil if none found.
+func (m *Achievement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Achievement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Count
+
+	// no validation rules for Target
+
+	if all {
+		switch v := interface{}(m.GetUnlockedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AchievementValidationError{
+					field:  "UnlockedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AchievementValidationError{
+					field:  "UnlockedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnlockedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AchievementValidationError{
+				field:  "UnlockedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AchievementMultiError(errors)
+	}
+
+	return nil
+}
+
+// AchievementMultiError is an error wrapping multiple validation errors
+// returned by Achievement.ValidateAll() if the designated constraints aren't met.
+type AchievementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AchievementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AchievementMultiError) AllErrors() []error { return m }
+
+// AchievementValidationError is the validation error returned by
+// Achievement.Validate if the designated constraints aren't met.
+type AchievementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AchievementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AchievementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AchievementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AchievementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AchievementValidationError) ErrorName() string { return "AchievementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AchievementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAchievement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AchievementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AchievementValidationError{}
+
+// Validate checks the field values on ListAchievements_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAchievements_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAchievements_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAchievements_RequestMultiError, or nil if none found.
+func (m *ListAchievements_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAchievements_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return ListAchievements_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAchievements_RequestMultiError is an error wrapping multiple validation
+// errors returned by ListAchievements_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ListAchievements_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAchievements_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAchievements_RequestMultiError) AllErrors() []error { return m }
+
+// ListAchievements_RequestValidationError is the validation error returned by
+// ListAchievements_Request.Validate if the designated constraints aren't met.
+type ListAchievements_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAchievements_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAchievements_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAchievements_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAchievements_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAchievements_RequestValidationError) ErrorName() string {
+	return "ListAchievements_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAchievements_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAchievements_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAchievements_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAchievements_RequestValidationError{}
+
+// Validate checks the field values on ListAchievements_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAchievements_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAchievements_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAchievements_ResponseMultiError, or nil if none found.
+func (m *ListAchievements_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAchievements_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAchievements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAchievements_ResponseValidationError{
+						field:  fmt.Sprintf("Achievements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAchievements_ResponseValidationError{
+						field:  fmt.Sprintf("Achievements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAchievements_ResponseValidationError{
+					field:  fmt.Sprintf("Achievements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAchievements_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAchievements_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAchievements_Response.ValidateAll() if the
+// designated constraints aren't met.
+type ListAchievements_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAchievements_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAchievements_ResponseMultiError) AllErrors() []error { return m }
+
+// ListAchievements_ResponseValidationError is the validation error returned by
+// ListAchievements_Response.Validate if the designated constraints aren't met.
+type ListAchievements_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAchievements_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAchievements_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAchievements_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAchievements_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAchievements_ResponseValidationError) ErrorName() string {
+	return "ListAchievements_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAchievements_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAchievements_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAchievements_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAchievements_ResponseValidationError{}
@@ -0,0 +1,1304 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.32.0
+// source: api/v1/daily/service.proto
+
+package daily
+
+import (
+	leaderboard "github.com/casnerano/snippet-war/pkg/api/v1/leaderboard"
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetDailyChallenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDailyChallenge) Reset() {
+	*x = GetDailyChallenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyChallenge) ProtoMessage() {}
+
+func (x *GetDailyChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyChallenge.ProtoReflect.Descriptor instead.
+func (*GetDailyChallenge) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{0}
+}
+
+type StartDailyChallenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartDailyChallenge) Reset() {
+	*x = StartDailyChallenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDailyChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDailyChallenge) ProtoMessage() {}
+
+func (x *StartDailyChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDailyChallenge.ProtoReflect.Descriptor instead.
+func (*StartDailyChallenge) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{1}
+}
+
+type SubmitAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitAnswer) Reset() {
+	*x = SubmitAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer) ProtoMessage() {}
+
+func (x *SubmitAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{2}
+}
+
+type GetDailyLeaderboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDailyLeaderboard) Reset() {
+	*x = GetDailyLeaderboard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyLeaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboard) ProtoMessage() {}
+
+func (x *GetDailyLeaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboard.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboard) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{3}
+}
+
+// Attempt is the single run of a user through the challenge of the day.
+type Attempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Midnight UTC of the challenge day.
+	Date     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Language quiz.Language          `protobuf:"varint,2,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	// Questions carry deadlines of the attempt.
+	Questions      []*quiz.Question       `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	Answers        []*Answer              `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`
+	Score          uint32                 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	CorrectAnswers uint32                 `protobuf:"varint,6,opt,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Set once every question is answered.
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *Attempt) Reset() {
+	*x = Attempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attempt) ProtoMessage() {}
+
+func (x *Attempt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attempt.ProtoReflect.Descriptor instead.
+func (*Attempt) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Attempt) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Attempt) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+func (x *Attempt) GetQuestions() []*quiz.Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *Attempt) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *Attempt) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Attempt) GetCorrectAnswers() uint32 {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return 0
+}
+
+func (x *Attempt) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Attempt) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type Answer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Correct    bool   `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+	Late       bool   `protobuf:"varint,3,opt,name=late,proto3" json:"late,omitempty"`
+	Points     uint32 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *Answer) Reset() {
+	*x = Answer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Answer) ProtoMessage() {}
+
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Answer) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *Answer) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *Answer) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *Answer) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// Streak counts consecutive days with a finished challenge in any language.
+type Streak struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Zero once a day is missed.
+	Current  uint32                 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	Best     uint32                 `protobuf:"varint,2,opt,name=best,proto3" json:"best,omitempty"`
+	LastDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_date,json=lastDate,proto3" json:"last_date,omitempty"`
+}
+
+func (x *Streak) Reset() {
+	*x = Streak{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Streak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Streak) ProtoMessage() {}
+
+func (x *Streak) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Streak.ProtoReflect.Descriptor instead.
+func (*Streak) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Streak) GetCurrent() uint32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *Streak) GetBest() uint32 {
+	if x != nil {
+		return x.Best
+	}
+	return 0
+}
+
+func (x *Streak) GetLastDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDate
+	}
+	return nil
+}
+
+type GetDailyChallenge_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language quiz.Language `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+}
+
+func (x *GetDailyChallenge_Request) Reset() {
+	*x = GetDailyChallenge_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyChallenge_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyChallenge_Request) ProtoMessage() {}
+
+func (x *GetDailyChallenge_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyChallenge_Request.ProtoReflect.Descriptor instead.
+func (*GetDailyChallenge_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *GetDailyChallenge_Request) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+type GetDailyChallenge_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempt *Attempt `protobuf:"bytes,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Streak  *Streak  `protobuf:"bytes,2,opt,name=streak,proto3" json:"streak,omitempty"`
+}
+
+func (x *GetDailyChallenge_Response) Reset() {
+	*x = GetDailyChallenge_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyChallenge_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyChallenge_Response) ProtoMessage() {}
+
+func (x *GetDailyChallenge_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyChallenge_Response.ProtoReflect.Descriptor instead.
+func (*GetDailyChallenge_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *GetDailyChallenge_Response) GetAttempt() *Attempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+func (x *GetDailyChallenge_Response) GetStreak() *Streak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+type StartDailyChallenge_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language quiz.Language `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+}
+
+func (x *StartDailyChallenge_Request) Reset() {
+	*x = StartDailyChallenge_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDailyChallenge_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDailyChallenge_Request) ProtoMessage() {}
+
+func (x *StartDailyChallenge_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDailyChallenge_Request.ProtoReflect.Descriptor instead.
+func (*StartDailyChallenge_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *StartDailyChallenge_Request) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+type StartDailyChallenge_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempt *Attempt `protobuf:"bytes,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Streak  *Streak  `protobuf:"bytes,2,opt,name=streak,proto3" json:"streak,omitempty"`
+}
+
+func (x *StartDailyChallenge_Response) Reset() {
+	*x = StartDailyChallenge_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDailyChallenge_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDailyChallenge_Response) ProtoMessage() {}
+
+func (x *StartDailyChallenge_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDailyChallenge_Response.ProtoReflect.Descriptor instead.
+func (*StartDailyChallenge_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *StartDailyChallenge_Response) GetAttempt() *Attempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+func (x *StartDailyChallenge_Response) GetStreak() *Streak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+type SubmitAnswer_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language quiz.Language              `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	Answer   *quiz.SubmitAnswer_Request `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *SubmitAnswer_Request) Reset() {
+	*x = SubmitAnswer_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer_Request) ProtoMessage() {}
+
+func (x *SubmitAnswer_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer_Request.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *SubmitAnswer_Request) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+func (x *SubmitAnswer_Request) GetAnswer() *quiz.SubmitAnswer_Request {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type SubmitAnswer_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempt *Attempt                    `protobuf:"bytes,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Streak  *Streak                     `protobuf:"bytes,2,opt,name=streak,proto3" json:"streak,omitempty"`
+	Result  *quiz.SubmitAnswer_Response `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *SubmitAnswer_Response) Reset() {
+	*x = SubmitAnswer_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer_Response) ProtoMessage() {}
+
+func (x *SubmitAnswer_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer_Response.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *SubmitAnswer_Response) GetAttempt() *Attempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+func (x *SubmitAnswer_Response) GetStreak() *Streak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+func (x *SubmitAnswer_Response) GetResult() *quiz.SubmitAnswer_Response {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetDailyLeaderboard_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language quiz.Language `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	// Any time of the day, today when unset.
+	Date  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Limit uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetDailyLeaderboard_Request) Reset() {
+	*x = GetDailyLeaderboard_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyLeaderboard_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboard_Request) ProtoMessage() {}
+
+func (x *GetDailyLeaderboard_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboard_Request.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboard_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *GetDailyLeaderboard_Request) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+func (x *GetDailyLeaderboard_Request) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GetDailyLeaderboard_Request) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDailyLeaderboard_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Entries []*leaderboard.Entry   `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Me      *leaderboard.Entry     `protobuf:"bytes,3,opt,name=me,proto3" json:"me,omitempty"`
+}
+
+func (x *GetDailyLeaderboard_Response) Reset() {
+	*x = GetDailyLeaderboard_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_daily_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyLeaderboard_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboard_Response) ProtoMessage() {}
+
+func (x *GetDailyLeaderboard_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_daily_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboard_Response.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboard_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_daily_service_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *GetDailyLeaderboard_Response) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GetDailyLeaderboard_Response) GetEntries() []*leaderboard.Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetDailyLeaderboard_Response) GetMe() *leaderboard.Entry {
+	if x != nil {
+		return x.Me
+	}
+	return nil
+}
+
+var File_api_v1_daily_service_proto protoreflect.FileDescriptor
+
+var file_api_v1_daily_service_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x1a, 0x41,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75,
+	0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x1a, 0x5b, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x07,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x22, 0xb5,
+	0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x1a, 0x41, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x1a, 0x5b, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12,
+	0x25, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x22, 0xa2, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a, 0x7f, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x71, 0x75, 0x69,
+	0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a, 0x90, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12,
+	0x25, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb7, 0x02, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x1a, 0x90, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x8c, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x02, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x02, 0x6d, 0x65, 0x22, 0xf3, 0x02, 0x0a, 0x07, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x2a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a,
+	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6f, 0x0a, 0x06, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x06,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x62, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x32, 0x8a, 0x04,
+	0x0a, 0x0e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x12, 0x75, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2d, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12,
+	0x22, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2d, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x70,
+	0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1b,
+	0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2d,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x12, 0x87, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x22, 0x2e, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x2d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2f, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73, 0x6e, 0x65, 0x72, 0x61,
+	0x6e, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x2d, 0x77, 0x61, 0x72, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x3b,
+	0x64, 0x61, 0x69, 0x6c, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1_daily_service_proto_rawDescOnce sync.Once
+	file_api_v1_daily_service_proto_rawDescData = file_api_v1_daily_service_proto_rawDesc
+)
+
+func file_api_v1_daily_service_proto_rawDescGZIP() []byte {
+	file_api_v1_daily_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_daily_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_daily_service_proto_rawDescData)
+	})
+	return file_api_v1_daily_service_proto_rawDescData
+}
+
+var file_api_v1_daily_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_api_v1_daily_service_proto_goTypes = []interface{}{
+	(*GetDailyChallenge)(nil),            // 0: daily.GetDailyChallenge
+	(*StartDailyChallenge)(nil),          // 1: daily.StartDailyChallenge
+	(*SubmitAnswer)(nil),                 // 2: daily.SubmitAnswer
+	(*GetDailyLeaderboard)(nil),          // 3: daily.GetDailyLeaderboard
+	(*Attempt)(nil),                      // 4: daily.Attempt
+	(*Answer)(nil),                       // 5: daily.Answer
+	(*Streak)(nil),                       // 6: daily.Streak
+	(*GetDailyChallenge_Request)(nil),    // 7: daily.GetDailyChallenge.Request
+	(*GetDailyChallenge_Response)(nil),   // 8: daily.GetDailyChallenge.Response
+	(*StartDailyChallenge_Request)(nil),  // 9: daily.StartDailyChallenge.Request
+	(*StartDailyChallenge_Response)(nil), // 10: daily.StartDailyChallenge.Response
+	(*SubmitAnswer_Request)(nil),         // 11: daily.SubmitAnswer.Request
+	(*SubmitAnswer_Response)(nil),        // 12: daily.SubmitAnswer.Response
+	(*GetDailyLeaderboard_Request)(nil),  // 13: daily.GetDailyLeaderboard.Request
+	(*GetDailyLeaderboard_Response)(nil), // 14: daily.GetDailyLeaderboard.Response
+	(*timestamppb.Timestamp)(nil),        // 15: google.protobuf.Timestamp
+	(quiz.Language)(0),                   // 16: quiz.Language
+	(*quiz.Question)(nil),                // 17: quiz.Question
+	(*quiz.SubmitAnswer_Request)(nil),    // 18: quiz.SubmitAnswer.Request
+	(*quiz.SubmitAnswer_Response)(nil),   // 19: quiz.SubmitAnswer.Response
+	(*leaderboard.Entry)(nil),            // 20: leaderboard.Entry
+}
+var file_api_v1_daily_service_proto_depIdxs = []int32{
+	15, // 0: daily.Attempt.date:type_name -> google.protobuf.Timestamp
+	16, // 1: daily.Attempt.language:type_name -> quiz.Language
+	17, // 2: daily.Attempt.questions:type_name -> quiz.Question
+	5,  // 3: daily.Attempt.answers:type_name -> daily.Answer
+	15, // 4: daily.Attempt.started_at:type_name -> google.protobuf.Timestamp
+	15, // 5: daily.Attempt.finished_at:type_name -> google.protobuf.Timestamp
+	15, // 6: daily.Streak.last_date:type_name -> google.protobuf.Timestamp
+	16, // 7: daily.GetDailyChallenge.Request.language:type_name -> quiz.Language
+	4,  // 8: daily.GetDailyChallenge.Response.attempt:type_name -> daily.Attempt
+	6,  // 9: daily.GetDailyChallenge.Response.streak:type_name -> daily.Streak
+	16, // 10: daily.StartDailyChallenge.Request.language:type_name -> quiz.Language
+	4,  // 11: daily.StartDailyChallenge.Response.attempt:type_name -> daily.Attempt
+	6,  // 12: daily.StartDailyChallenge.Response.streak:type_name -> daily.Streak
+	16, // 13: daily.SubmitAnswer.Request.language:type_name -> quiz.Language
+	18, // 14: daily.SubmitAnswer.Request.answer:type_name -> quiz.SubmitAnswer.Request
+	4,  // 15: daily.SubmitAnswer.Response.attempt:type_name -> daily.Attempt
+	6,  // 16: daily.SubmitAnswer.Response.streak:type_name -> daily.Streak
+	19, // 17: daily.SubmitAnswer.Response.result:type_name -> quiz.SubmitAnswer.Response
+	16, // 18: daily.GetDailyLeaderboard.Request.language:type_name -> quiz.Language
+	15, // 19: daily.GetDailyLeaderboard.Request.date:type_name -> google.protobuf.Timestamp
+	15, // 20: daily.GetDailyLeaderboard.Response.date:type_name -> google.protobuf.Timestamp
+	20, // 21: daily.GetDailyLeaderboard.Response.entries:type_name -> leaderboard.Entry
+	20, // 22: daily.GetDailyLeaderboard.Response.me:type_name -> leaderboard.Entry
+	7,  // 23: daily.DailyChallenge.GetDailyChallenge:input_type -> daily.GetDailyChallenge.Request
+	9,  // 24: daily.DailyChallenge.StartDailyChallenge:input_type -> daily.StartDailyChallenge.Request
+	11, // 25: daily.DailyChallenge.SubmitAnswer:input_type -> daily.SubmitAnswer.Request
+	13, // 26: daily.DailyChallenge.GetDailyLeaderboard:input_type -> daily.GetDailyLeaderboard.Request
+	8,  // 27: daily.DailyChallenge.GetDailyChallenge:output_type -> daily.GetDailyChallenge.Response
+	10, // 28: daily.DailyChallenge.StartDailyChallenge:output_type -> daily.StartDailyChallenge.Response
+	12, // 29: daily.DailyChallenge.SubmitAnswer:output_type -> daily.SubmitAnswer.Response
+	14, // 30: daily.DailyChallenge.GetDailyLeaderboard:output_type -> daily.GetDailyLeaderboard.Response
+	27, // [27:31] is the sub-list for method output_type
+	23, // [23:27] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_daily_service_proto_init() }
+func file_api_v1_daily_service_proto_init() {
+	if File_api_v1_daily_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v1_daily_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyChallenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDailyChallenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyLeaderboard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Answer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Streak); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyChallenge_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyChallenge_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDailyChallenge_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDailyChallenge_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyLeaderboard_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_daily_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDailyLeaderboard_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_v1_daily_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_daily_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_daily_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_daily_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_daily_service_proto = out.File
+	file_api_v1_daily_service_proto_rawDesc = nil
+	file_api_v1_daily_service_proto_goTypes = nil
+	file_api_v1_daily_service_proto_depIdxs = nil
+}
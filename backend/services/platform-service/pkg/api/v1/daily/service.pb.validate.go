@@ -0,0 +1,2240 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/v1/daily/service.proto
+
+package daily
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = quiz.Language(0)
+)
+
+// Validate checks the field values on GetDailyChallenge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetDailyChallenge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDailyChallenge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDailyChallengeMultiError, or nil if none found.
+func (m *GetDailyChallenge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDailyChallenge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetDailyChallengeMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDailyChallengeMultiError is an error wrapping multiple validation errors
+// returned by GetDailyChallenge.ValidateAll() if the designated constraints
+// aren't met.
+type GetDailyChallengeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDailyChallengeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDailyChallengeMultiError) AllErrors() []error { return m }
+
+// GetDailyChallengeValidationError is the validation error returned by
+// GetDailyChallenge.Validate if the designated constraints aren't met.
+type GetDailyChallengeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDailyChallengeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDailyChallengeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDailyChallengeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDailyChallengeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDailyChallengeValidationError) ErrorName() string {
+	return "GetDailyChallengeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDailyChallengeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDailyChallenge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDailyChallengeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDailyChallengeValidationError{}
+
+// Validate checks the field values on StartDailyChallenge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartDailyChallenge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDailyChallenge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartDailyChallengeMultiError, or nil if none found.
+func (m *StartDailyChallenge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDailyChallenge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StartDailyChallengeMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDailyChallengeMultiError is an error wrapping multiple validation
+// errors returned by StartDailyChallenge.ValidateAll() if the designated
+// constraints aren't met.
+type StartDailyChallengeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDailyChallengeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDailyChallengeMultiError) AllErrors() []error { return m }
+
+// StartDailyChallengeValidationError is the validation error returned by
+// StartDailyChallenge.Validate if the designated constraints aren't met.
+type StartDailyChallengeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDailyChallengeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDailyChallengeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDailyChallengeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDailyChallengeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDailyChallengeValidationError) ErrorName() string {
+	return "StartDailyChallengeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartDailyChallengeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDailyChallenge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDailyChallengeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDailyChallengeValidationError{}
+
+// Validate checks the field values on SubmitAnswer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubmitAnswerMultiError, or
+// nil if none found.
+func (m *SubmitAnswer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SubmitAnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswerMultiError is an error wrapping multiple validation errors
+// returned by SubmitAnswer.ValidateAll() if the designated constraints aren't met.
+type SubmitAnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswerMultiError) AllErrors() []error { return m }
+
+// SubmitAnswerValidationError is the validation error returned by
+// SubmitAnswer.Validate if the designated constraints aren't met.
+type SubmitAnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswerValidationError) ErrorName() string { return "SubmitAnswerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswerValidationError{}
+
+// Validate checks the field values on GetDailyLeaderboard with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDailyLeaderboard) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDailyLeaderboard with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDailyLeaderboardMultiError, or nil if none found.
+func (m *GetDailyLeaderboard) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDailyLeaderboard) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetDailyLeaderboardMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDailyLeaderboardMultiError is an error wrapping multiple validation
+// errors returned by GetDailyLeaderboard.ValidateAll() if the designated
+// constraints aren't met.
+type GetDailyLeaderboardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDailyLeaderboardMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDailyLeaderboardMultiError) AllErrors() []error { return m }
+
+// GetDailyLeaderboardValidationError is the validation error returned by
+// GetDailyLeaderboard.Validate if the designated constraints aren't met.
+type GetDailyLeaderboardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDailyLeaderboardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDailyLeaderboardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDailyLeaderboardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDailyLeaderboardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDailyLeaderboardValidationError) ErrorName() string {
+	return "GetDailyLeaderboardValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDailyLeaderboardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDailyLeaderboard.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDailyLeaderboardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDailyLeaderboardValidationError{}
+
+// Validate checks the field values on Attempt with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attempt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attempt with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AttemptMultiError, or nil if none found.
+func (m *Attempt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attempt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttemptValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttemptValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttemptValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Language
+
+	for idx, item := range m.GetQuestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttemptValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttemptValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttemptValidationError{
+					field:  fmt.Sprintf("Questions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAnswers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttemptValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttemptValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttemptValidationError{
+					field:  fmt.Sprintf("Answers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Score
+
+	// no validation rules for CorrectAnswers
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttemptValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttemptValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttemptValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttemptValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttemptValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttemptValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttemptMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttemptMultiError is an error wrapping multiple validation errors returned
+// by Attempt.ValidateAll() if the designated constraints aren't met.
+type AttemptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttemptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttemptMultiError) AllErrors() []error { return m }
+
+// AttemptValidationError is the validation error returned by Attempt.Validate
+// if the designated constraints aren't met.
+type AttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttemptValidationError) ErrorName() string { return "AttemptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttemptValidationError{}
+
+// Validate checks the field values on Answer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Answer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Answer with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AnswerMultiError, or nil if none found.
+func (m *Answer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Answer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuestionId
+
+	// no validation rules for Correct
+
+	// no validation rules for Late
+
+	// no validation rules for Points
+
+	if len(errors) > 0 {
+		return AnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnswerMultiError is an error wrapping multiple validation errors returned by
+// Answer.ValidateAll() if the designated constraints aren't met.
+type AnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnswerMultiError) AllErrors() []error { return m }
+
+// AnswerValidationError is the validation error returned by Answer.Validate if
+// the designated constraints aren't met.
+type AnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnswerValidationError) ErrorName() string { return "AnswerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnswerValidationError{}
+
+// Validate checks the field values on Streak with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Streak) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Streak with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StreakMultiError, or nil if none found.
+func (m *Streak) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Streak) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Current
+
+	// no validation rules for Best
+
+	if all {
+		switch v := interface{}(m.GetLastDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreakValidationError{
+					field:  "LastDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreakValidationError{
+					field:  "LastDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreakValidationError{
+				field:  "LastDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreakMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreakMultiError is an error wrapping multiple validation errors returned by
+// Streak.ValidateAll() if the designated constraints aren't met.
+type StreakMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreakMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreakMultiError) AllErrors() []error { return m }
+
+// StreakValidationError is the validation error returned by Streak.Validate if
+// the designated constraints aren't met.
+type StreakValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreakValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreakValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreakValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreakValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreakValidationError) ErrorName() string { return "StreakValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StreakValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreak.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreakValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreakValidationError{}
+
+// Validate checks the field values on GetDailyChallenge_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDailyChallenge_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDailyChallenge_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDailyChallenge_RequestMultiError, or nil if none found.
+func (m *GetDailyChallenge_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDailyChallenge_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _GetDailyChallenge_Request_Language_NotInLookup[m.GetLanguage()]; ok {
+		err := GetDailyChallenge_RequestValidationError{
+			field:  "Language",
+			reason: "value must not be in list [LANGUAGE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := quiz.Language_name[int32(m.GetLanguage())]; !ok {
+		err := GetDailyChallenge_RequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDailyChallenge_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDailyChallenge_RequestMultiError is an error wrapping multiple validation
+// errors returned by GetDailyChallenge_Request.ValidateAll() if the
+// designated constraints aren't met.
+type GetDailyChallenge_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDailyChallenge_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDailyChallenge_RequestMultiError) AllErrors() []error { return m }
+
+// GetDailyChallenge_RequestValidationError is the validation error returned by
+// GetDailyChallenge_Request.Validate if the designated constraints aren't met.
+type GetDailyChallenge_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDailyChallenge_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDailyChallenge_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDailyChallenge_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDailyChallenge_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDailyChallenge_RequestValidationError) ErrorName() string {
+	return "GetDailyChallenge_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDailyChallenge_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDailyChallenge_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDailyChallenge_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDailyChallenge_RequestValidationError{}
+
+var _GetDailyChallenge_Request_Language_NotInLookup = map[quiz.Language]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetDailyChallenge_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDailyChallenge_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDailyChallenge_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDailyChallenge_ResponseMultiError, or nil if none found.
+func (m *GetDailyChallenge_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDailyChallenge_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttempt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDailyChallenge_ResponseValidationError{
+					field:  "Attempt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDailyChallenge_ResponseValidationError{
+					field:  "Attempt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttempt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDailyChallenge_ResponseValidationError{
+				field:  "Attempt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStreak()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDailyChallenge_ResponseValidationError{
+					field:  "Streak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDailyChallenge_ResponseValidationError{
+					field:  "Streak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStreak()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDailyChallenge_ResponseValidationError{
+				field:  "Streak",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDailyChallenge_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDailyChallenge_ResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDailyChallenge_Response.ValidateAll() if
+// the designated constraints aren't met.
+type GetDailyChallenge_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDailyChallenge_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDailyChallenge_ResponseMultiError) AllErrors() []error { return m }
+
+// GetDailyChallenge_ResponseValidationError is the validation error returned
+// by GetDailyChallenge_Response.Validate if the designated constraints aren't met.
+type GetDailyChallenge_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDailyChallenge_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDailyChallenge_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDailyChallenge_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDailyChallenge_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDailyChallenge_ResponseValidationError) ErrorName() string {
+	return "GetDailyChallenge_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDailyChallenge_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDailyChallenge_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDailyChallenge_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDailyChallenge_ResponseValidationError{}
+
+// Validate checks the field values on StartDailyChallenge_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartDailyChallenge_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDailyChallenge_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartDailyChallenge_RequestMultiError, or nil if none found.
+func (m *StartDailyChallenge_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDailyChallenge_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _StartDailyChallenge_Request_Language_NotInLookup[m.GetLanguage()]; ok {
+		err := StartDailyChallenge_RequestValidationError{
+			field:  "Language",
+			reason: "value must not be in list [LANGUAGE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := quiz.Language_name[int32(m.GetLanguage())]; !ok {
+		err := StartDailyChallenge_RequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartDailyChallenge_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDailyChallenge_RequestMultiError is an error wrapping multiple
+// validation errors returned by StartDailyChallenge_Request.ValidateAll() if
+// the designated constraints aren't met.
+type StartDailyChallenge_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDailyChallenge_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDailyChallenge_RequestMultiError) AllErrors() []error { return m }
+
+// StartDailyChallenge_RequestValidationError is the validation error returned
+// by StartDailyChallenge_Request.Validate if the designated constraints
+// aren't met.
+type StartDailyChallenge_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDailyChallenge_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDailyChallenge_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDailyChallenge_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDailyChallenge_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDailyChallenge_RequestValidationError) ErrorName() string {
+	return "StartDailyChallenge_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartDailyChallenge_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDailyChallenge_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDailyChallenge_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDailyChallenge_RequestValidationError{}
+
+var _StartDailyChallenge_Request_Language_NotInLookup = map[quiz.Language]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on StartDailyChallenge_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartDailyChallenge_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDailyChallenge_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartDailyChallenge_ResponseMultiError, or nil if none found.
+func (m *StartDailyChallenge_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDailyChallenge_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttempt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDailyChallenge_ResponseValidationError{
+					field:  "Attempt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDailyChallenge_ResponseValidationError{
+					field:  "Attempt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttempt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDailyChallenge_ResponseValidationError{
+				field:  "Attempt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStreak()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDailyChallenge_ResponseValidationError{
+					field:  "Streak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDailyChallenge_ResponseValidationError{
+					field:  "Streak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStreak()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDailyChallenge_ResponseValidationError{
+				field:  "Streak",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartDailyChallenge_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDailyChallenge_ResponseMultiError is an error wrapping multiple
+// validation errors returned by StartDailyChallenge_Response.ValidateAll() if
+// the designated constraints aren't met.
+type StartDailyChallenge_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDailyChallenge_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDailyChallenge_ResponseMultiError) AllErrors() []error { return m }
+
+// StartDailyChallenge_ResponseValidationError is the validation error returned
+// by StartDailyChallenge_Response.Validate if the designated constraints
+// aren't met.
+type StartDailyChallenge_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDailyChallenge_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDailyChallenge_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDailyChallenge_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDailyChallenge_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDailyChallenge_ResponseValidationError) ErrorName() string {
+	return "StartDailyChallenge_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartDailyChallenge_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDailyChallenge_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDailyChallenge_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDailyChallenge_ResponseValidationError{}
+
+// Validate checks the field values on SubmitAnswer_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_RequestMultiError, or nil if none found.
+func (m *SubmitAnswer_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _SubmitAnswer_Request_Language_NotInLookup[m.GetLanguage()]; ok {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "Language",
+			reason: "value must not be in list [LANGUAGE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := quiz.Language_name[int32(m.GetLanguage())]; !ok {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnswer() == nil {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "Answer",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnswer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_RequestValidationError{
+					field:  "Answer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_RequestValidationError{
+					field:  "Answer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_RequestValidationError{
+				field:  "Answer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_RequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitAnswer_Request.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_RequestMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_RequestValidationError is the validation error returned by
+// SubmitAnswer_Request.Validate if the designated constraints aren't met.
+type SubmitAnswer_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_RequestValidationError) ErrorName() string {
+	return "SubmitAnswer_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_RequestValidationError{}
+
+var _SubmitAnswer_Request_Language_NotInLookup = map[quiz.Language]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SubmitAnswer_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_ResponseMultiError, or nil if none found.
+func (m *SubmitAnswer_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttempt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Attempt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Attempt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttempt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_ResponseValidationError{
+				field:  "Attempt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStreak()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Streak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Streak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStreak()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_ResponseValidationError{
+				field:  "Streak",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_ResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_ResponseMultiError is an error wrapping multiple validation
+// errors returned by SubmitAnswer_Response.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_ResponseMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_ResponseValidationError is the validation error returned by
+// SubmitAnswer_Response.Validate if the designated constraints aren't met.
+type SubmitAnswer_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_ResponseValidationError) ErrorName() string {
+	return "SubmitAnswer_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_ResponseValidationError{}
+
+// Validate checks the field values on GetDailyLeaderboard_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDailyLeaderboard_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDailyLeaderboard_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDailyLeaderboard_RequestMultiError, or nil if none found.
+func (m *GetDailyLeaderboard_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDailyLeaderboard_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _GetDailyLeaderboard_Request_Language_NotInLookup[m.GetLanguage()]; ok {
+		err := GetDailyLeaderboard_RequestValidationError{
+			field:  "Language",
+			reason: "value must not be in list [LANGUAGE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := quiz.Language_name[int32(m.GetLanguage())]; !ok {
+		err := GetDailyLeaderboard_RequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDailyLeaderboard_RequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDailyLeaderboard_RequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDailyLeaderboard_RequestValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLimit() > 100 {
+		err := GetDailyLeaderboard_RequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDailyLeaderboard_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDailyLeaderboard_RequestMultiError is an error wrapping multiple
+// validation errors returned by GetDailyLeaderboard_Request.ValidateAll() if
+// the designated constraints aren't met.
+type GetDailyLeaderboard_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDailyLeaderboard_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDailyLeaderboard_RequestMultiError) AllErrors() []error { return m }
+
+// GetDailyLeaderboard_RequestValidationError is the validation error returned
+// by GetDailyLeaderboard_Request.Validate if the designated constraints
+// aren't met.
+type GetDailyLeaderboard_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDailyLeaderboard_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDailyLeaderboard_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDailyLeaderboard_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDailyLeaderboard_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDailyLeaderboard_RequestValidationError) ErrorName() string {
+	return "GetDailyLeaderboard_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDailyLeaderboard_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDailyLeaderboard_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDailyLeaderboard_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDailyLeaderboard_RequestValidationError{}
+
+var _GetDailyLeaderboard_Request_Language_NotInLookup = map[quiz.Language]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetDailyLeaderboard_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDailyLeaderboard_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDailyLeaderboard_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDailyLeaderboard_ResponseMultiError, or nil if none found.
+func (m *GetDailyLeaderboard_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDailyLeaderboard_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDailyLeaderboard_ResponseValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDailyLeaderboard_ResponseValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDailyLeaderboard_ResponseValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDailyLeaderboard_ResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDailyLeaderboard_ResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDailyLeaderboard_ResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMe()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDailyLeaderboard_ResponseValidationError{
+					field:  "Me",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDailyLeaderboard_ResponseValidationError{
+					field:  "Me",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMe()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDailyLeaderboard_ResponseValidationError{
+				field:  "Me",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDailyLeaderboard_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDailyLeaderboard_ResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDailyLeaderboard_Response.ValidateAll() if
+// the designated constraints aren't met.
+type GetDailyLeaderboard_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDailyLeaderboard_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDailyLeaderboard_ResponseMultiError) AllErrors() []error { return m }
+
+// GetDailyLeaderboard_ResponseValidationError is the validation error returned
+// by GetDailyLeaderboard_Response.Validate if the designated constraints
+// aren't met.
+type GetDailyLeaderboard_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDailyLeaderboard_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDailyLeaderboard_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDailyLeaderboard_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDailyLeaderboard_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDailyLeaderboard_ResponseValidationError) ErrorName() string {
+	return "GetDailyLeaderboard_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDailyLeaderboard_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDailyLeaderboard_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDailyLeaderboard_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDailyLeaderboard_ResponseValidationError{}
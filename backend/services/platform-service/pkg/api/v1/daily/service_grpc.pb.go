@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: api/v1/daily/service.proto
+
+package daily
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DailyChallengeClient is the client API for DailyChallenge service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DailyChallengeClient interface {
+	// GetDailyChallenge returns the attempt of the user, unset until the
+	// attempt is started, and the streak.
+	GetDailyChallenge(ctx context.Context, in *GetDailyChallenge_Request, opts ...grpc.CallOption) (*GetDailyChallenge_Response, error)
+	// StartDailyChallenge starts the attempt of the user, time limits of
+	// questions run from then. Later calls return the same attempt.
+	StartDailyChallenge(ctx context.Context, in *StartDailyChallenge_Request, opts ...grpc.CallOption) (*StartDailyChallenge_Response, error)
+	SubmitAnswer(ctx context.Context, in *SubmitAnswer_Request, opts ...grpc.CallOption) (*SubmitAnswer_Response, error)
+	GetDailyLeaderboard(ctx context.Context, in *GetDailyLeaderboard_Request, opts ...grpc.CallOption) (*GetDailyLeaderboard_Response, error)
+}
+
+type dailyChallengeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDailyChallengeClient(cc grpc.ClientConnInterface) DailyChallengeClient {
+	return &dailyChallengeClient{cc}
+}
+
+func (c *dailyChallengeClient) GetDailyChallenge(ctx context.Context, in *GetDailyChallenge_Request, opts ...grpc.CallOption) (*GetDailyChallenge_Response, error) {
+	out := new(GetDailyChallenge_Response)
+	err := c.cc.Invoke(ctx, "/daily.DailyChallenge/GetDailyChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dailyChallengeClient) StartDailyChallenge(ctx context.Context, in *StartDailyChallenge_Request, opts ...grpc.CallOption) (*StartDailyChallenge_Response, error) {
+	out := new(StartDailyChallenge_Response)
+	err := c.cc.Invoke(ctx, "/daily.DailyChallenge/StartDailyChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dailyChallengeClient) SubmitAnswer(ctx context.Context, in *SubmitAnswer_Request, opts ...grpc.CallOption) (*SubmitAnswer_Response, error) {
+	out := new(SubmitAnswer_Response)
+	err := c.cc.Invoke(ctx, "/daily.DailyChallenge/SubmitAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dailyChallengeClient) GetDailyLeaderboard(ctx context.Context, in *GetDailyLeaderboard_Request, opts ...grpc.CallOption) (*GetDailyLeaderboard_Response, error) {
+	out := new(GetDailyLeaderboard_Response)
+	err := c.cc.Invoke(ctx, "/daily.DailyChallenge/GetDailyLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DailyChallengeServer is the server API for DailyChallenge service.
+// All implementations must embed UnimplementedDailyChallengeServer
+// for forward compatibility
+type DailyChallengeServer interface {
+	// GetDailyChallenge returns the attempt of the user, unset until the
+	// attempt is started, and the streak.
+	GetDailyChallenge(context.Context, *GetDailyChallenge_Request) (*GetDailyChallenge_Response, error)
+	// StartDailyChallenge starts the attempt of the user, time limits of
+	// questions run from then. Later calls return the same attempt.
+	StartDailyChallenge(context.Context, *StartDailyChallenge_Request) (*StartDailyChallenge_Response, error)
+	SubmitAnswer(context.Context, *SubmitAnswer_Request) (*SubmitAnswer_Response, error)
+	GetDailyLeaderboard(context.Context, *GetDailyLeaderboard_Request) (*GetDailyLeaderboard_Response, error)
+	mustEmbedUnimplementedDailyChallengeServer()
+}
+
+// UnimplementedDailyChallengeServer must be embedded to have forward compatible implementations.
+type UnimplementedDailyChallengeServer struct {
+}
+
+func (UnimplementedDailyChallengeServer) GetDailyChallenge(context.Context, *GetDailyChallenge_Request) (*GetDailyChallenge_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyChallenge not implemented")
+}
+func (UnimplementedDailyChallengeServer) StartDailyChallenge(context.Context, *StartDailyChallenge_Request) (*StartDailyChallenge_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDailyChallenge not implemented")
+}
+func (UnimplementedDailyChallengeServer) SubmitAnswer(context.Context, *SubmitAnswer_Request) (*SubmitAnswer_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnswer not implemented")
+}
+func (UnimplementedDailyChallengeServer) GetDailyLeaderboard(context.Context, *GetDailyLeaderboard_Request) (*GetDailyLeaderboard_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyLeaderboard not implemented")
+}
+func (UnimplementedDailyChallengeServer) mustEmbedUnimplementedDailyChallengeServer() {}
+
+// UnsafeDailyChallengeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DailyChallengeServer will
+// result in compilation errors.
+type UnsafeDailyChallengeServer interface {
+	mustEmbedUnimplementedDailyChallengeServer()
+}
+
+func RegisterDailyChallengeServer(s grpc.ServiceRegistrar, srv DailyChallengeServer) {
+	s.RegisterService(&DailyChallenge_ServiceDesc, srv)
+}
+
+func _DailyChallenge_GetDailyChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyChallenge_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DailyChallengeServer).GetDailyChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daily.DailyChallenge/GetDailyChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DailyChallengeServer).GetDailyChallenge(ctx, req.(*GetDailyChallenge_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DailyChallenge_StartDailyChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDailyChallenge_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DailyChallengeServer).StartDailyChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daily.DailyChallenge/StartDailyChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DailyChallengeServer).StartDailyChallenge(ctx, req.(*StartDailyChallenge_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DailyChallenge_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAnswer_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DailyChallengeServer).SubmitAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daily.DailyChallenge/SubmitAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DailyChallengeServer).SubmitAnswer(ctx, req.(*SubmitAnswer_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DailyChallenge_GetDailyLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyLeaderboard_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DailyChallengeServer).GetDailyLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/daily.DailyChallenge/GetDailyLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DailyChallengeServer).GetDailyLeaderboard(ctx, req.(*GetDailyLeaderboard_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DailyChallenge_ServiceDesc is the grpc.ServiceDesc for DailyChallenge service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DailyChallenge_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "daily.DailyChallenge",
+	HandlerType: (*DailyChallengeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDailyChallenge",
+			Handler:    _DailyChallenge_GetDailyChallenge_Handler,
+		},
+		{
+			MethodName: "StartDailyChallenge",
+			Handler:    _DailyChallenge_StartDailyChallenge_Handler,
+		},
+		{
+			MethodName: "SubmitAnswer",
+			Handler:    _DailyChallenge_SubmitAnswer_Handler,
+		},
+		{
+			MethodName: "GetDailyLeaderboard",
+			Handler:    _DailyChallenge_GetDailyLeaderboard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/daily/service.proto",
+}
@@ -0,0 +1,1223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.32.0
+// source: api/v1/duel/service.proto
+
+package duel
+
+import (
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Outcome int32
+
+const (
+	Outcome_OUTCOME_UNSPECIFIED Outcome = 0
+	Outcome_OUTCOME_WIN         Outcome = 1
+	Outcome_OUTCOME_LOSS        Outcome = 2
+	Outcome_OUTCOME_DRAW        Outcome = 3
+)
+
+// Enum value maps for Outcome.
+var (
+	Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_WIN",
+		2: "OUTCOME_LOSS",
+		3: "OUTCOME_DRAW",
+	}
+	Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED": 0,
+		"OUTCOME_WIN":         1,
+		"OUTCOME_LOSS":        2,
+		"OUTCOME_DRAW":        3,
+	}
+)
+
+func (x Outcome) Enum() *Outcome {
+	p := new(Outcome)
+	*p = x
+	return p
+}
+
+func (x Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_duel_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Outcome) Type() protoreflect.EnumType {
+	return &file_api_v1_duel_service_proto_enumTypes[0]
+}
+
+func (x Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Outcome.Descriptor instead.
+func (Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{0}
+}
+
+type Duel_Event_Type int32
+
+const (
+	Duel_Event_TYPE_UNSPECIFIED    Duel_Event_Type = 0
+	Duel_Event_TYPE_WAITING        Duel_Event_Type = 1
+	Duel_Event_TYPE_MATCHED        Duel_Event_Type = 2
+	Duel_Event_TYPE_QUESTION       Duel_Event_Type = 3
+	Duel_Event_TYPE_ROUND_FINISHED Duel_Event_Type = 4
+	Duel_Event_TYPE_FINISHED       Duel_Event_Type = 5
+)
+
+// Enum value maps for Duel_Event_Type.
+var (
+	Duel_Event_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_WAITING",
+		2: "TYPE_MATCHED",
+		3: "TYPE_QUESTION",
+		4: "TYPE_ROUND_FINISHED",
+		5: "TYPE_FINISHED",
+	}
+	Duel_Event_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":    0,
+		"TYPE_WAITING":        1,
+		"TYPE_MATCHED":        2,
+		"TYPE_QUESTION":       3,
+		"TYPE_ROUND_FINISHED": 4,
+		"TYPE_FINISHED":       5,
+	}
+)
+
+func (x Duel_Event_Type) Enum() *Duel_Event_Type {
+	p := new(Duel_Event_Type)
+	*p = x
+	return p
+}
+
+func (x Duel_Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Duel_Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_duel_service_proto_enumTypes[1].Descriptor()
+}
+
+func (Duel_Event_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_duel_service_proto_enumTypes[1]
+}
+
+func (x Duel_Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Duel_Event_Type.Descriptor instead.
+func (Duel_Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+type Match_Status int32
+
+const (
+	Match_STATUS_UNSPECIFIED Match_Status = 0
+	Match_STATUS_ACTIVE      Match_Status = 1
+	Match_STATUS_FINISHED    Match_Status = 2
+	Match_STATUS_ABORTED     Match_Status = 3
+)
+
+// Enum value maps for Match_Status.
+var (
+	Match_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACTIVE",
+		2: "STATUS_FINISHED",
+		3: "STATUS_ABORTED",
+	}
+	Match_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_ACTIVE":      1,
+		"STATUS_FINISHED":    2,
+		"STATUS_ABORTED":     3,
+	}
+)
+
+func (x Match_Status) Enum() *Match_Status {
+	p := new(Match_Status)
+	*p = x
+	return p
+}
+
+func (x Match_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Match_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_duel_service_proto_enumTypes[2].Descriptor()
+}
+
+func (Match_Status) Type() protoreflect.EnumType {
+	return &file_api_v1_duel_service_proto_enumTypes[2]
+}
+
+func (x Match_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Match_Status.Descriptor instead.
+func (Match_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type Duel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Duel) Reset() {
+	*x = Duel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Duel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Duel) ProtoMessage() {}
+
+func (x *Duel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Duel.ProtoReflect.Descriptor instead.
+func (*Duel) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{0}
+}
+
+type SubmitAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitAnswer) Reset() {
+	*x = SubmitAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer) ProtoMessage() {}
+
+func (x *SubmitAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{1}
+}
+
+type Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status      Match_Status    `protobuf:"varint,2,opt,name=status,proto3,enum=duel.Match_Status" json:"status,omitempty"`
+	Language    quiz.Language   `protobuf:"varint,3,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	Topics      []string        `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics,omitempty"`
+	Difficulty  quiz.Difficulty `protobuf:"varint,5,opt,name=difficulty,proto3,enum=quiz.Difficulty" json:"difficulty,omitempty"`
+	Players     []*Player       `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`
+	TotalRounds uint32          `protobuf:"varint,7,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
+	// Zero when the match is not finished or ended in a draw.
+	WinnerId   int64                  `protobuf:"varint,8,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Match) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Match) GetStatus() Match_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Match_STATUS_UNSPECIFIED
+}
+
+func (x *Match) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+func (x *Match) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *Match) GetDifficulty() quiz.Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return quiz.Difficulty(0)
+}
+
+func (x *Match) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Match) GetTotalRounds() uint32 {
+	if x != nil {
+		return x.TotalRounds
+	}
+	return 0
+}
+
+func (x *Match) GetWinnerId() int64 {
+	if x != nil {
+		return x.WinnerId
+	}
+	return 0
+}
+
+func (x *Match) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Match) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName    string               `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bot            bool                 `protobuf:"varint,3,opt,name=bot,proto3" json:"bot,omitempty"`
+	CorrectAnswers uint32               `protobuf:"varint,4,opt,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	AnswerTime     *durationpb.Duration `protobuf:"bytes,5,opt,name=answer_time,json=answerTime,proto3" json:"answer_time,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Player) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Player) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Player) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+func (x *Player) GetCorrectAnswers() uint32 {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return 0
+}
+
+func (x *Player) GetAnswerTime() *durationpb.Duration {
+	if x != nil {
+		return x.AnswerTime
+	}
+	return nil
+}
+
+type Round struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number   uint32                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Question *quiz.Question         `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Answers and correct answers are only set once the round is finished.
+	Answers        []*Answer `protobuf:"bytes,5,rep,name=answers,proto3" json:"answers,omitempty"`
+	CorrectAnswers []string  `protobuf:"bytes,6,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+}
+
+func (x *Round) Reset() {
+	*x = Round{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Round) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Round) ProtoMessage() {}
+
+func (x *Round) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Round.ProtoReflect.Descriptor instead.
+func (*Round) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Round) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Round) GetQuestion() *quiz.Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *Round) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *Round) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Round) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *Round) GetCorrectAnswers() []string {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return nil
+}
+
+type Answer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Answered bool                 `protobuf:"varint,2,opt,name=answered,proto3" json:"answered,omitempty"`
+	Correct  bool                 `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	Elapsed  *durationpb.Duration `protobuf:"bytes,4,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+}
+
+func (x *Answer) Reset() {
+	*x = Answer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Answer) ProtoMessage() {}
+
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Answer) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Answer) GetAnswered() bool {
+	if x != nil {
+		return x.Answered
+	}
+	return false
+}
+
+func (x *Answer) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *Answer) GetElapsed() *durationpb.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	return nil
+}
+
+type Duel_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language   quiz.Language   `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	Difficulty quiz.Difficulty `protobuf:"varint,2,opt,name=difficulty,proto3,enum=quiz.Difficulty" json:"difficulty,omitempty"`
+}
+
+func (x *Duel_Request) Reset() {
+	*x = Duel_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Duel_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Duel_Request) ProtoMessage() {}
+
+func (x *Duel_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Duel_Request.ProtoReflect.Descriptor instead.
+func (*Duel_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Duel_Request) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+func (x *Duel_Request) GetDifficulty() quiz.Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return quiz.Difficulty(0)
+}
+
+type Duel_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    Duel_Event_Type `protobuf:"varint,1,opt,name=type,proto3,enum=duel.Duel_Event_Type" json:"type,omitempty"`
+	Match   *Match          `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+	Round   *Round          `protobuf:"bytes,3,opt,name=round,proto3" json:"round,omitempty"`
+	Outcome Outcome         `protobuf:"varint,4,opt,name=outcome,proto3,enum=duel.Outcome" json:"outcome,omitempty"`
+}
+
+func (x *Duel_Event) Reset() {
+	*x = Duel_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Duel_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Duel_Event) ProtoMessage() {}
+
+func (x *Duel_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Duel_Event.ProtoReflect.Descriptor instead.
+func (*Duel_Event) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Duel_Event) GetType() Duel_Event_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Duel_Event_TYPE_UNSPECIFIED
+}
+
+func (x *Duel_Event) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *Duel_Event) GetRound() *Round {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+func (x *Duel_Event) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+type SubmitAnswer_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DuelId string                     `protobuf:"bytes,1,opt,name=duel_id,json=duelId,proto3" json:"duel_id,omitempty"`
+	Answer *quiz.SubmitAnswer_Request `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *SubmitAnswer_Request) Reset() {
+	*x = SubmitAnswer_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer_Request) ProtoMessage() {}
+
+func (x *SubmitAnswer_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer_Request.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SubmitAnswer_Request) GetDuelId() string {
+	if x != nil {
+		return x.DuelId
+	}
+	return ""
+}
+
+func (x *SubmitAnswer_Request) GetAnswer() *quiz.SubmitAnswer_Request {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type SubmitAnswer_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result  *quiz.SubmitAnswer_Response `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Elapsed *durationpb.Duration        `protobuf:"bytes,2,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+}
+
+func (x *SubmitAnswer_Response) Reset() {
+	*x = SubmitAnswer_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_duel_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer_Response) ProtoMessage() {}
+
+func (x *SubmitAnswer_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_duel_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer_Response.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_duel_service_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *SubmitAnswer_Response) GetResult() *quiz.SubmitAnswer_Response {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SubmitAnswer_Response) GetElapsed() *durationpb.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	return nil
+}
+
+var File_api_v1_duel_service_proto protoreflect.FileDescriptor
+
+var file_api_v1_duel_service_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x75, 0x65, 0x6c, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x64, 0x75, 0x65,
+	0x6c, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x03, 0x0a, 0x04, 0x44, 0x75, 0x65, 0x6c, 0x1a, 0x7f, 0x0a,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69,
+	0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82,
+	0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01,
+	0x20, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x1a, 0xa2,
+	0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x75, 0x65, 0x6c, 0x2e, 0x44, 0x75,
+	0x65, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x64, 0x75, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x64, 0x75, 0x65, 0x6c, 0x2e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x64, 0x75, 0x65,
+	0x6c, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x10, 0x05, 0x22, 0xef, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x1a, 0x69, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x07, 0x64, 0x75, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x64, 0x75, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a,
+	0x74, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x71, 0x75,
+	0x69, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x33, 0x0a, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6c,
+	0x61, 0x70, 0x73, 0x65, 0x64, 0x22, 0xf7, 0x03, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x64, 0x75, 0x65, 0x6c, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12,
+	0x30, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x75, 0x65, 0x6c, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x5c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x03, 0x22,
+	0xbb, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8d, 0x02,
+	0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x26, 0x0a, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x64, 0x75, 0x65, 0x6c, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x8c, 0x01,
+	0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x2a, 0x57, 0x0a, 0x07,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x55, 0x54, 0x43, 0x4f,
+	0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4c, 0x4f, 0x53,
+	0x53, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x44,
+	0x52, 0x41, 0x57, 0x10, 0x03, 0x32, 0xa7, 0x01, 0x0a, 0x05, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x12,
+	0x2e, 0x0a, 0x04, 0x44, 0x75, 0x65, 0x6c, 0x12, 0x12, 0x2e, 0x64, 0x75, 0x65, 0x6c, 0x2e, 0x44,
+	0x75, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x75,
+	0x65, 0x6c, 0x2e, 0x44, 0x75, 0x65, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x6e, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12,
+	0x1a, 0x2e, 0x64, 0x75, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x75,
+	0x65, 0x6c, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x75, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x64, 0x75, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x42,
+	0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61,
+	0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x2d,
+	0x77, 0x61, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64,
+	0x75, 0x65, 0x6c, 0x3b, 0x64, 0x75, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1_duel_service_proto_rawDescOnce sync.Once
+	file_api_v1_duel_service_proto_rawDescData = file_api_v1_duel_service_proto_rawDesc
+)
+
+func file_api_v1_duel_service_proto_rawDescGZIP() []byte {
+	file_api_v1_duel_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_duel_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_duel_service_proto_rawDescData)
+	})
+	return file_api_v1_duel_service_proto_rawDescData
+}
+
+var file_api_v1_duel_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_duel_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_duel_service_proto_goTypes = []interface{}{
+	(Outcome)(0),                       // 0: duel.Outcome
+	(Duel_Event_Type)(0),               // 1: duel.Duel.Event.Type
+	(Match_Status)(0),                  // 2: duel.Match.Status
+	(*Duel)(nil),                       // 3: duel.Duel
+	(*SubmitAnswer)(nil),               // 4: duel.SubmitAnswer
+	(*Match)(nil),                      // 5: duel.Match
+	(*Player)(nil),                     // 6: duel.Player
+	(*Round)(nil),                      // 7: duel.Round
+	(*Answer)(nil),                     // 8: duel.Answer
+	(*Duel_Request)(nil),               // 9: duel.Duel.Request
+	(*Duel_Event)(nil),                 // 10: duel.Duel.Event
+	(*SubmitAnswer_Request)(nil),       // 11: duel.SubmitAnswer.Request
+	(*SubmitAnswer_Response)(nil),      // 12: duel.SubmitAnswer.Response
+	(quiz.Language)(0),                 // 13: quiz.Language
+	(quiz.Difficulty)(0),               // 14: quiz.Difficulty
+	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 16: google.protobuf.Duration
+	(*quiz.Question)(nil),              // 17: quiz.Question
+	(*quiz.SubmitAnswer_Request)(nil),  // 18: quiz.SubmitAnswer.Request
+	(*quiz.SubmitAnswer_Response)(nil), // 19: quiz.SubmitAnswer.Response
+}
+var file_api_v1_duel_service_proto_depIdxs = []int32{
+	2,  // 0: duel.Match.status:type_name -> duel.Match.Status
+	13, // 1: duel.Match.language:type_name -> quiz.Language
+	14, // 2: duel.Match.difficulty:type_name -> quiz.Difficulty
+	6,  // 3: duel.Match.players:type_name -> duel.Player
+	15, // 4: duel.Match.started_at:type_name -> google.protobuf.Timestamp
+	15, // 5: duel.Match.finished_at:type_name -> google.protobuf.Timestamp
+	16, // 6: duel.Player.answer_time:type_name -> google.protobuf.Duration
+	17, // 7: duel.Round.question:type_name -> quiz.Question
+	15, // 8: duel.Round.issued_at:type_name -> google.protobuf.Timestamp
+	15, // 9: duel.Round.deadline:type_name -> google.protobuf.Timestamp
+	8,  // 10: duel.Round.answers:type_name -> duel.Answer
+	16, // 11: duel.Answer.elapsed:type_name -> google.protobuf.Duration
+	13, // 12: duel.Duel.Request.language:type_name -> quiz.Language
+	14, // 13: duel.Duel.Request.difficulty:type_name -> quiz.Difficulty
+	1,  // 14: duel.Duel.Event.type:type_name -> duel.Duel.Event.Type
+	5,  // 15: duel.Duel.Event.match:type_name -> duel.Match
+	7,  // 16: duel.Duel.Event.round:type_name -> duel.Round
+	0,  // 17: duel.Duel.Event.outcome:type_name -> duel.Outcome
+	18, // 18: duel.SubmitAnswer.Request.answer:type_name -> quiz.SubmitAnswer.Request
+	19, // 19: duel.SubmitAnswer.Response.result:type_name -> quiz.SubmitAnswer.Response
+	16, // 20: duel.SubmitAnswer.Response.elapsed:type_name -> google.protobuf.Duration
+	9,  // 21: duel.Arena.Duel:input_type -> duel.Duel.Request
+	11, // 22: duel.Arena.SubmitAnswer:input_type -> duel.SubmitAnswer.Request
+	10, // 23: duel.Arena.Duel:output_type -> duel.Duel.Event
+	12, // 24: duel.Arena.SubmitAnswer:output_type -> duel.SubmitAnswer.Response
+	23, // [23:25] is the sub-list for method output_type
+	21, // [21:23] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_duel_service_proto_init() }
+func file_api_v1_duel_service_proto_init() {
+	if File_api_v1_duel_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v1_duel_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Duel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Round); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Answer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Duel_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Duel_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_duel_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_v1_duel_service_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_duel_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_duel_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_duel_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_duel_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_duel_service_proto = out.File
+	file_api_v1_duel_service_proto_rawDesc = nil
+	file_api_v1_duel_service_proto_goTypes = nil
+	file_api_v1_duel_service_proto_depIdxs = nil
+}
@@ -0,0 +1,1553 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/v1/duel/service.proto
+
+package duel
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = quiz.Language(0)
+)
+
+// Validate checks the field values on Duel with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Duel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Duel with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in DuelMultiError, or nil if none found.
+func (m *Duel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Duel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DuelMultiError(errors)
+	}
+
+	return nil
+}
+
+// DuelMultiError is an error wrapping multiple validation errors returned by
+// Duel.ValidateAll() if the designated constraints aren't met.
+type DuelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DuelMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DuelMultiError) AllErrors() []error { return m }
+
+// DuelValidationError is the validation error returned by Duel.Validate if the
+// designated constraints aren't met.
+type DuelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DuelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DuelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DuelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DuelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DuelValidationError) ErrorName() string { return "DuelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DuelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDuel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DuelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DuelValidationError{}
+
+// Validate checks the field values on SubmitAnswer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubmitAnswerMultiError, or
+// nil if none found.
+func (m *SubmitAnswer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SubmitAnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswerMultiError is an error wrapping multiple validation errors
+// returned by SubmitAnswer.ValidateAll() if the designated constraints aren't met.
+type SubmitAnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswerMultiError) AllErrors() []error { return m }
+
+// SubmitAnswerValidationError is the validation error returned by
+// SubmitAnswer.Validate if the designated constraints aren't met.
+type SubmitAnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswerValidationError) ErrorName() string { return "SubmitAnswerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswerValidationError{}
+
+// Validate checks the field values on Match with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Match) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Match with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MatchMultiError, or nil if none found.
+func (m *Match) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Match) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Language
+
+	// no validation rules for Difficulty
+
+	for idx, item := range m.GetPlayers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MatchValidationError{
+						field:  fmt.Sprintf("Players[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MatchValidationError{
+						field:  fmt.Sprintf("Players[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MatchValidationError{
+					field:  fmt.Sprintf("Players[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalRounds
+
+	// no validation rules for WinnerId
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MatchValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MatchValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MatchValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MatchValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchMultiError is an error wrapping multiple validation errors returned by
+// Match.ValidateAll() if the designated constraints aren't met.
+type MatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchMultiError) AllErrors() []error { return m }
+
+// MatchValidationError is the validation error returned by Match.Validate if
+// the designated constraints aren't met.
+type MatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchValidationError) ErrorName() string { return "MatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchValidationError{}
+
+// Validate checks the field values on Player with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Player) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Player with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PlayerMultiError, or nil if none found.
+func (m *Player) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Player) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Bot
+
+	// no validation rules for CorrectAnswers
+
+	if all {
+		switch v := interface{}(m.GetAnswerTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlayerValidationError{
+					field:  "AnswerTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlayerValidationError{
+					field:  "AnswerTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswerTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlayerValidationError{
+				field:  "AnswerTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PlayerMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlayerMultiError is an error wrapping multiple validation errors returned by
+// Player.ValidateAll() if the designated constraints aren't met.
+type PlayerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlayerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlayerMultiError) AllErrors() []error { return m }
+
+// PlayerValidationError is the validation error returned by Player.Validate if
+// the designated constraints aren't met.
+type PlayerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlayerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlayerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlayerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlayerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlayerValidationError) ErrorName() string { return "PlayerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlayerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlayer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlayerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlayerValidationError{}
+
+// Validate checks the field values on Round with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Round) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Round with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RoundMultiError, or nil if none found.
+func (m *Round) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Round) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if all {
+		switch v := interface{}(m.GetQuestion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoundValidationError{
+					field:  "Question",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoundValidationError{
+					field:  "Question",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuestion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoundValidationError{
+				field:  "Question",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIssuedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoundValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoundValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssuedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoundValidationError{
+				field:  "IssuedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoundValidationError{
+					field:  "Deadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoundValidationError{
+					field:  "Deadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoundValidationError{
+				field:  "Deadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAnswers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoundValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoundValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoundValidationError{
+					field:  fmt.Sprintf("Answers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RoundMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoundMultiError is an error wrapping multiple validation errors returned by
+// Round.ValidateAll() if the designated constraints aren't met.
+type RoundMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoundMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoundMultiError) AllErrors() []error { return m }
+
+// RoundValidationError is the validation error returned by Round.Validate if
+// the designated constraints aren't met.
+type RoundValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoundValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoundValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoundValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoundValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoundValidationError) ErrorName() string { return "RoundValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoundValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRound.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoundValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoundValidationError{}
+
+// Validate checks the field values on Answer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Answer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Answer with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AnswerMultiError, or nil if none found.
+func (m *Answer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Answer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Answered
+
+	// no validation rules for Correct
+
+	if all {
+		switch v := interface{}(m.GetElapsed()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnswerValidationError{
+					field:  "Elapsed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnswerValidationError{
+					field:  "Elapsed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetElapsed()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnswerValidationError{
+				field:  "Elapsed",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnswerMultiError is an error wrapping multiple validation errors returned by
+// Answer.ValidateAll() if the designated constraints aren't met.
+type AnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnswerMultiError) AllErrors() []error { return m }
+
+// AnswerValidationError is the validation error returned by Answer.Validate if
+// the designated constraints aren't met.
+type AnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnswerValidationError) ErrorName() string { return "AnswerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnswerValidationError{}
+
+// Validate checks the field values on Duel_Request with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Duel_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Duel_Request with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Duel_RequestMultiError, or
+// nil if none found.
+func (m *Duel_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Duel_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Duel_Request_Language_NotInLookup[m.GetLanguage()]; ok {
+		err := Duel_RequestValidationError{
+			field:  "Language",
+			reason: "value must not be in list [LANGUAGE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := quiz.Language_name[int32(m.GetLanguage())]; !ok {
+		err := Duel_RequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Duel_Request_Difficulty_NotInLookup[m.GetDifficulty()]; ok {
+		err := Duel_RequestValidationError{
+			field:  "Difficulty",
+			reason: "value must not be in list [DIFFICULTY_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := quiz.Difficulty_name[int32(m.GetDifficulty())]; !ok {
+		err := Duel_RequestValidationError{
+			field:  "Difficulty",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Duel_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// Duel_RequestMultiError is an error wrapping multiple validation errors
+// returned by Duel_Request.ValidateAll() if the designated constraints aren't met.
+type Duel_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Duel_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Duel_RequestMultiError) AllErrors() []error { return m }
+
+// Duel_RequestValidationError is the validation error returned by
+// Duel_Request.Validate if the designated constraints aren't met.
+type Duel_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Duel_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Duel_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Duel_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Duel_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Duel_RequestValidationError) ErrorName() string { return "Duel_RequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Duel_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDuel_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Duel_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Duel_RequestValidationError{}
+
+var _Duel_Request_Language_NotInLookup = map[quiz.Language]struct{}{
+	0: {},
+}
+
+var _Duel_Request_Difficulty_NotInLookup = map[quiz.Difficulty]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Duel_Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Duel_Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Duel_Event with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Duel_EventMultiError, or
+// nil if none found.
+func (m *Duel_Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Duel_Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetMatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Duel_EventValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Duel_EventValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Duel_EventValidationError{
+				field:  "Match",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRound()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Duel_EventValidationError{
+					field:  "Round",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Duel_EventValidationError{
+					field:  "Round",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRound()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Duel_EventValidationError{
+				field:  "Round",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Outcome
+
+	if len(errors) > 0 {
+		return Duel_EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// Duel_EventMultiError is an error wrapping multiple validation errors
+// returned by Duel_Event.ValidateAll() if the designated constraints aren't met.
+type Duel_EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Duel_EventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Duel_EventMultiError) AllErrors() []error { return m }
+
+// Duel_EventValidationError is the validation error returned by
+// Duel_Event.Validate if the designated constraints aren't met.
+type Duel_EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Duel_EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Duel_EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Duel_EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Duel_EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Duel_EventValidationError) ErrorName() string { return "Duel_EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Duel_EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDuel_Event.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Duel_EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Duel_EventValidationError{}
+
+// Validate checks the field values on SubmitAnswer_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_RequestMultiError, or nil if none found.
+func (m *SubmitAnswer_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDuelId()) < 1 {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "DuelId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnswer() == nil {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "Answer",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnswer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_RequestValidationError{
+					field:  "Answer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_RequestValidationError{
+					field:  "Answer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_RequestValidationError{
+				field:  "Answer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_RequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitAnswer_Request.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_RequestMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_RequestValidationError is the validation error returned by
+// SubmitAnswer_Request.Validate if the designated constraints aren't met.
+type SubmitAnswer_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_RequestValidationError) ErrorName() string {
+	return "SubmitAnswer_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_RequestValidationError{}
+
+// Validate checks the field values on SubmitAnswer_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_ResponseMultiError, or nil if none found.
+func (m *SubmitAnswer_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_ResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetElapsed()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Elapsed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Elapsed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetElapsed()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_ResponseValidationError{
+				field:  "Elapsed",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_ResponseMultiError is an error wrapping multiple validation
+// errors returned by SubmitAnswer_Response.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_ResponseMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_ResponseValidationError is the validation error returned by
+// SubmitAnswer_Response.Validate if the designated constraints aren't met.
+type SubmitAnswer_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_ResponseValidationError) ErrorName() string {
+	return "SubmitAnswer_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_ResponseValidationError{}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: api/v1/duel/service.proto
+
+package duel
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ArenaClient is the client API for Arena service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ArenaClient interface {
+	// Duel queues the player for an opponent with the same language and
+	// difficulty and streams the match until it is finished.
+	Duel(ctx context.Context, in *Duel_Request, opts ...grpc.CallOption) (Arena_DuelClient, error)
+	SubmitAnswer(ctx context.Context, in *SubmitAnswer_Request, opts ...grpc.CallOption) (*SubmitAnswer_Response, error)
+}
+
+type arenaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArenaClient(cc grpc.ClientConnInterface) ArenaClient {
+	return &arenaClient{cc}
+}
+
+func (c *arenaClient) Duel(ctx context.Context, in *Duel_Request, opts ...grpc.CallOption) (Arena_DuelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Arena_ServiceDesc.Streams[0], "/duel.Arena/Duel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arenaDuelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Arena_DuelClient interface {
+	Recv() (*Duel_Event, error)
+	grpc.ClientStream
+}
+
+type arenaDuelClient struct {
+	grpc.ClientStream
+}
+
+func (x *arenaDuelClient) Recv() (*Duel_Event, error) {
+	m := new(Duel_Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arenaClient) SubmitAnswer(ctx context.Context, in *SubmitAnswer_Request, opts ...grpc.CallOption) (*SubmitAnswer_Response, error) {
+	out := new(SubmitAnswer_Response)
+	err := c.cc.Invoke(ctx, "/duel.Arena/SubmitAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArenaServer is the server API for Arena service.
+// All implementations must embed UnimplementedArenaServer
+// for forward compatibility
+type ArenaServer interface {
+	// Duel queues the player for an opponent with the same language and
+	// difficulty and streams the match until it is finished.
+	Duel(*Duel_Request, Arena_DuelServer) error
+	SubmitAnswer(context.Context, *SubmitAnswer_Request) (*SubmitAnswer_Response, error)
+	mustEmbedUnimplementedArenaServer()
+}
+
+// UnimplementedArenaServer must be embedded to have forward compatible implementations.
+type UnimplementedArenaServer struct {
+}
+
+func (UnimplementedArenaServer) Duel(*Duel_Request, Arena_DuelServer) error {
+	return status.Errorf(codes.Unimplemented, "method Duel not implemented")
+}
+func (UnimplementedArenaServer) SubmitAnswer(context.Context, *SubmitAnswer_Request) (*SubmitAnswer_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnswer not implemented")
+}
+func (UnimplementedArenaServer) mustEmbedUnimplementedArenaServer() {}
+
+// UnsafeArenaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArenaServer will
+// result in compilation errors.
+type UnsafeArenaServer interface {
+	mustEmbedUnimplementedArenaServer()
+}
+
+func RegisterArenaServer(s grpc.ServiceRegistrar, srv ArenaServer) {
+	s.RegisterService(&Arena_ServiceDesc, srv)
+}
+
+func _Arena_Duel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Duel_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArenaServer).Duel(m, &arenaDuelServer{stream})
+}
+
+type Arena_DuelServer interface {
+	Send(*Duel_Event) error
+	grpc.ServerStream
+}
+
+type arenaDuelServer struct {
+	grpc.ServerStream
+}
+
+func (x *arenaDuelServer) Send(m *Duel_Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Arena_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAnswer_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArenaServer).SubmitAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/duel.Arena/SubmitAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArenaServer).SubmitAnswer(ctx, req.(*SubmitAnswer_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Arena_ServiceDesc is the grpc.ServiceDesc for Arena service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Arena_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "duel.Arena",
+	HandlerType: (*ArenaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitAnswer",
+			Handler:    _Arena_SubmitAnswer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Duel",
+			Handler:       _Arena_Duel_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/v1/duel/service.proto",
+}
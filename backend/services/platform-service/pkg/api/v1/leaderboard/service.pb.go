@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.32.0
+// source: api/v1/leaderboard/service.proto
+
+package leaderboard
+
+import (
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Period int32
+
+const (
+	Period_PERIOD_UNSPECIFIED Period = 0
+	Period_PERIOD_ALL_TIME    Period = 1
+	Period_PERIOD_WEEKLY      Period = 2
+	Period_PERIOD_DAILY       Period = 3
+)
+
+// Enum value maps for Period.
+var (
+	Period_name = map[int32]string{
+		0: "PERIOD_UNSPECIFIED",
+		1: "PERIOD_ALL_TIME",
+		2: "PERIOD_WEEKLY",
+		3: "PERIOD_DAILY",
+	}
+	Period_value = map[string]int32{
+		"PERIOD_UNSPECIFIED": 0,
+		"PERIOD_ALL_TIME":    1,
+		"PERIOD_WEEKLY":      2,
+		"PERIOD_DAILY":       3,
+	}
+)
+
+func (x Period) Enum() *Period {
+	p := new(Period)
+	*p = x
+	return p
+}
+
+func (x Period) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Period) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_leaderboard_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Period) Type() protoreflect.EnumType {
+	return &file_api_v1_leaderboard_service_proto_enumTypes[0]
+}
+
+func (x Period) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Period.Descriptor instead.
+func (Period) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_leaderboard_service_proto_rawDescGZIP(), []int{0}
+}
+
+type GetLeaderboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLeaderboard) Reset() {
+	*x = GetLeaderboard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_leaderboard_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboard) ProtoMessage() {}
+
+func (x *GetLeaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_leaderboard_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboard.ProtoReflect.Descriptor instead.
+func (*GetLeaderboard) Descriptor() ([]byte, []int) {
+	return file_api_v1_leaderboard_service_proto_rawDescGZIP(), []int{0}
+}
+
+type Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank        uint32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId      int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Score       uint64 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_leaderboard_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_leaderboard_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_api_v1_leaderboard_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Entry) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Entry) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Entry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Entry) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetLeaderboard_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period     Period          `protobuf:"varint,1,opt,name=period,proto3,enum=leaderboard.Period" json:"period,omitempty"`
+	Language   quiz.Language   `protobuf:"varint,2,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	Difficulty quiz.Difficulty `protobuf:"varint,3,opt,name=difficulty,proto3,enum=quiz.Difficulty" json:"difficulty,omitempty"`
+	Limit      uint32          `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetLeaderboard_Request) Reset() {
+	*x = GetLeaderboard_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_leaderboard_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboard_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboard_Request) ProtoMessage() {}
+
+func (x *GetLeaderboard_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_leaderboard_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboard_Request.ProtoReflect.Descriptor instead.
+func (*GetLeaderboard_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_leaderboard_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *GetLeaderboard_Request) GetPeriod() Period {
+	if x != nil {
+		return x.Period
+	}
+	return Period_PERIOD_UNSPECIFIED
+}
+
+func (x *GetLeaderboard_Request) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+func (x *GetLeaderboard_Request) GetDifficulty() quiz.Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return quiz.Difficulty(0)
+}
+
+func (x *GetLeaderboard_Request) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetLeaderboard_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Me      *Entry   `protobuf:"bytes,2,opt,name=me,proto3" json:"me,omitempty"`
+}
+
+func (x *GetLeaderboard_Response) Reset() {
+	*x = GetLeaderboard_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_leaderboard_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboard_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboard_Response) ProtoMessage() {}
+
+func (x *GetLeaderboard_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_leaderboard_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboard_Response.ProtoReflect.Descriptor instead.
+func (*GetLeaderboard_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_leaderboard_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *GetLeaderboard_Response) GetEntries() []*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboard_Response) GetMe() *Entry {
+	if x != nil {
+		return x.Me
+	}
+	return nil
+}
+
+var File_api_v1_leaderboard_service_proto protoreflect.FileDescriptor
+
+var file_api_v1_leaderboard_service_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x71,
+	0x75, 0x69, 0x7a, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xc4, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x1a, 0xd3, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20,
+	0x00, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75,
+	0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x3a, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
+	0x02, 0x18, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x5c, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x02, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x02, 0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2a, 0x5a, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x45, 0x52,
+	0x49, 0x4f, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10,
+	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x44, 0x41, 0x49, 0x4c,
+	0x59, 0x10, 0x03, 0x32, 0x83, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x74, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e,
+	0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x2d, 0x77, 0x61, 0x72, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x3b, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1_leaderboard_service_proto_rawDescOnce sync.Once
+	file_api_v1_leaderboard_service_proto_rawDescData = file_api_v1_leaderboard_service_proto_rawDesc
+)
+
+func file_api_v1_leaderboard_service_proto_rawDescGZIP() []byte {
+	file_api_v1_leaderboard_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_leaderboard_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_leaderboard_service_proto_rawDescData)
+	})
+	return file_api_v1_leaderboard_service_proto_rawDescData
+}
+
+var file_api_v1_leaderboard_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_leaderboard_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_v1_leaderboard_service_proto_goTypes = []interface{}{
+	(Period)(0),                     // 0: leaderboard.Period
+	(*GetLeaderboard)(nil),          // 1: leaderboard.GetLeaderboard
+	(*Entry)(nil),                   // 2: leaderboard.Entry
+	(*GetLeaderboard_Request)(nil),  // 3: leaderboard.GetLeaderboard.Request
+	(*GetLeaderboard_Response)(nil), // 4: leaderboard.GetLeaderboard.Response
+	(quiz.Language)(0),              // 5: quiz.Language
+	(quiz.Difficulty)(0),            // 6: quiz.Difficulty
+}
+var file_api_v1_leaderboard_service_proto_depIdxs = []int32{
+	0, // 0: leaderboard.GetLeaderboard.Request.period:type_name -> leaderboard.Period
+	5, // 1: leaderboard.GetLeaderboard.Request.language:type_name -> quiz.Language
+	6, // 2: leaderboard.GetLeaderboard.Request.difficulty:type_name -> quiz.Difficulty
+	2, // 3: leaderboard.GetLeaderboard.Response.entries:type_name -> leaderboard.Entry
+	2, // 4: leaderboard.GetLeaderboard.Response.me:type_name -> leaderboard.Entry
+	3, // 5: leaderboard.Leaderboard.GetLeaderboard:input_type -> leaderboard.GetLeaderboard.Request
+	4, // 6: leaderboard.Leaderboard.GetLeaderboard:output_type -> leaderboard.GetLeaderboard.Response
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_leaderboard_service_proto_init() }
+func file_api_v1_leaderboard_service_proto_init() {
+	if File_api_v1_leaderboard_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v1_leaderboard_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_leaderboard_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_leaderboard_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboard_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_leaderboard_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboard_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_v1_leaderboard_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_leaderboard_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_leaderboard_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_leaderboard_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_leaderboard_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_leaderboard_service_proto = out.File
+	file_api_v1_leaderboard_service_proto_rawDesc = nil
+	file_api_v1_leaderboard_service_proto_goTypes = nil
+	file_api_v1_leaderboard_service_proto_depIdxs = nil
+}
@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/v1/leaderboard/service.proto
+
+package leaderboard
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = quiz.Language(0)
+)
+
+// Validate checks the field values on GetLeaderboard with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetLeaderboard) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLeaderboard with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetLeaderboardMultiError,
+// or nil if none found.
+func (m *GetLeaderboard) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLeaderboard) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetLeaderboardMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLeaderboardMultiError is an error wrapping multiple validation errors
+// returned by GetLeaderboard.ValidateAll() if the designated constraints
+// aren't met.
+type GetLeaderboardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLeaderboardMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLeaderboardMultiError) AllErrors() []error { return m }
+
+// GetLeaderboardValidationError is the validation error returned by
+// GetLeaderboard.Validate if the designated constraints aren't met.
+type GetLeaderboardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeaderboardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeaderboardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeaderboardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeaderboardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeaderboardValidationError) ErrorName() string { return "GetLeaderboardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetLeaderboardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeaderboard.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeaderboardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeaderboardValidationError{}
+
+// Validate checks the field values on Entry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Entry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Entry with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EntryMultiError, or nil if none found.
+func (m *Entry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Entry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rank
+
+	// no validation rules for UserId
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return EntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntryMultiError is an error wrapping multiple validation errors returned by
+// Entry.ValidateAll() if the designated constraints aren't met.
+type EntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntryMultiError) AllErrors() []error { return m }
+
+// EntryValidationError is the validation error returned by Entry.Validate if
+// the designated constraints aren't met.
+type EntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntryValidationError) ErrorName() string { return "EntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntryValidationError{}
+
+// Validate checks the field values on GetLeaderboard_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLeaderboard_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLeaderboard_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLeaderboard_RequestMultiError, or nil if none found.
+func (m *GetLeaderboard_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLeaderboard_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _GetLeaderboard_Request_Period_NotInLookup[m.GetPeriod()]; ok {
+		err := GetLeaderboard_RequestValidationError{
+			field:  "Period",
+			reason: "value must not be in list [PERIOD_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Period_name[int32(m.GetPeriod())]; !ok {
+		err := GetLeaderboard_RequestValidationError{
+			field:  "Period",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := quiz.Language_name[int32(m.GetLanguage())]; !ok {
+		err := GetLeaderboard_RequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := quiz.Difficulty_name[int32(m.GetDifficulty())]; !ok {
+		err := GetLeaderboard_RequestValidationError{
+			field:  "Difficulty",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 100 {
+		err := GetLeaderboard_RequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetLeaderboard_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLeaderboard_RequestMultiError is an error wrapping multiple validation
+// errors returned by GetLeaderboard_Request.ValidateAll() if the designated
+// constraints aren't met.
+type GetLeaderboard_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLeaderboard_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLeaderboard_RequestMultiError) AllErrors() []error { return m }
+
+// GetLeaderboard_RequestValidationError is the validation error returned by
+// GetLeaderboard_Request.Validate if the designated constraints aren't met.
+type GetLeaderboard_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeaderboard_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeaderboard_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeaderboard_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeaderboard_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeaderboard_RequestValidationError) ErrorName() string {
+	return "GetLeaderboard_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLeaderboard_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeaderboard_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeaderboard_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeaderboard_RequestValidationError{}
+
+var _GetLeaderboard_Request_Period_NotInLookup = map[Period]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetLeaderboard_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLeaderboard_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLeaderboard_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLeaderboard_ResponseMultiError, or nil if none found.
+func (m *GetLeaderboard_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLeaderboard_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetLeaderboard_ResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetLeaderboard_ResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetLeaderboard_ResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMe()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetLeaderboard_ResponseValidationError{
+					field:  "Me",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetLeaderboard_ResponseValidationError{
+					field:  "Me",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMe()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetLeaderboard_ResponseValidationError{
+				field:  "Me",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetLeaderboard_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLeaderboard_ResponseMultiError is an error wrapping multiple validation
+// errors returned by GetLeaderboard_Response.ValidateAll() if the designated
+// constraints aren't met.
+type GetLeaderboard_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLeaderboard_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLeaderboard_ResponseMultiError) AllErrors() []error { return m }
+
+// GetLeaderboard_ResponseValidationError is the validation error returned by
+// GetLeaderboard_Response.Validate if the designated constraints aren't met.
+type GetLeaderboard_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeaderboard_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeaderboard_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeaderboard_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeaderboard_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeaderboard_ResponseValidationError) ErrorName() string {
+	return "GetLeaderboard_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLeaderboard_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeaderboard_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeaderboard_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeaderboard_ResponseValidationError{}
@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: api/v1/leaderboard/service.proto
+
+package leaderboard
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LeaderboardClient is the client API for Leaderboard service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeaderboardClient interface {
+	GetLeaderboard(ctx context.Context, in *GetLeaderboard_Request, opts ...grpc.CallOption) (*GetLeaderboard_Response, error)
+}
+
+type leaderboardClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeaderboardClient(cc grpc.ClientConnInterface) LeaderboardClient {
+	return &leaderboardClient{cc}
+}
+
+func (c *leaderboardClient) GetLeaderboard(ctx context.Context, in *GetLeaderboard_Request, opts ...grpc.CallOption) (*GetLeaderboard_Response, error) {
+	out := new(GetLeaderboard_Response)
+	err := c.cc.Invoke(ctx, "/leaderboard.Leaderboard/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeaderboardServer is the server API for Leaderboard service.
+// All implementations must embed UnimplementedLeaderboardServer
+// for forward compatibility
+type LeaderboardServer interface {
+	GetLeaderboard(context.Context, *GetLeaderboard_Request) (*GetLeaderboard_Response, error)
+	mustEmbedUnimplementedLeaderboardServer()
+}
+
+// UnimplementedLeaderboardServer must be embedded to have forward compatible implementations.
+type UnimplementedLeaderboardServer struct {
+}
+
+func (UnimplementedLeaderboardServer) GetLeaderboard(context.Context, *GetLeaderboard_Request) (*GetLeaderboard_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedLeaderboardServer) mustEmbedUnimplementedLeaderboardServer() {}
+
+// UnsafeLeaderboardServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeaderboardServer will
+// result in compilation errors.
+type UnsafeLeaderboardServer interface {
+	mustEmbedUnimplementedLeaderboardServer()
+}
+
+func RegisterLeaderboardServer(s grpc.ServiceRegistrar, srv LeaderboardServer) {
+	s.RegisterService(&Leaderboard_ServiceDesc, srv)
+}
+
+func _Leaderboard_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboard_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaderboard.Leaderboard/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServer).GetLeaderboard(ctx, req.(*GetLeaderboard_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Leaderboard_ServiceDesc is the grpc.ServiceDesc for Leaderboard service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Leaderboard_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "leaderboard.Leaderboard",
+	HandlerType: (*LeaderboardServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _Leaderboard_GetLeaderboard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/leaderboard/service.proto",
+}
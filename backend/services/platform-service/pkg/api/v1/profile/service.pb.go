@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.32.0
+// source: api/v1/profile/service.proto
+
+package profile
+
+import (
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetProfile) Reset() {
+	*x = GetProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_profile_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfile) ProtoMessage() {}
+
+func (x *GetProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_profile_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfile.ProtoReflect.Descriptor instead.
+func (*GetProfile) Descriptor() ([]byte, []int) {
+	return file_api_v1_profile_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Profile is the progress of the user, every question counts once with the
+// first answer given.
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Xp          uint64 `protobuf:"varint,3,opt,name=xp,proto3" json:"xp,omitempty"`
+	Level       uint32 `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	// XP at which the current level starts.
+	LevelXp uint64 `protobuf:"varint,5,opt,name=level_xp,json=levelXp,proto3" json:"level_xp,omitempty"`
+	// XP at which the next level starts.
+	NextLevelXp uint64 `protobuf:"varint,6,opt,name=next_level_xp,json=nextLevelXp,proto3" json:"next_level_xp,omitempty"`
+	Answered    uint32 `protobuf:"varint,7,opt,name=answered,proto3" json:"answered,omitempty"`
+	Correct     uint32 `protobuf:"varint,8,opt,name=correct,proto3" json:"correct,omitempty"`
+	// Share of correct answers, from 0 to 1.
+	Accuracy float64    `protobuf:"fixed64,9,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	Mastery  []*Mastery `protobuf:"bytes,10,rep,name=mastery,proto3" json:"mastery,omitempty"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_profile_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_profile_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_api_v1_profile_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Profile) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetXp() uint64 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *Profile) GetLevel() uint32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Profile) GetLevelXp() uint64 {
+	if x != nil {
+		return x.LevelXp
+	}
+	return 0
+}
+
+func (x *Profile) GetNextLevelXp() uint64 {
+	if x != nil {
+		return x.NextLevelXp
+	}
+	return 0
+}
+
+func (x *Profile) GetAnswered() uint32 {
+	if x != nil {
+		return x.Answered
+	}
+	return 0
+}
+
+func (x *Profile) GetCorrect() uint32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *Profile) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Profile) GetMastery() []*Mastery {
+	if x != nil {
+		return x.Mastery
+	}
+	return nil
+}
+
+type Mastery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language quiz.Language `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	Topic    string        `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Answered uint32        `protobuf:"varint,3,opt,name=answered,proto3" json:"answered,omitempty"`
+	Correct  uint32        `protobuf:"varint,4,opt,name=correct,proto3" json:"correct,omitempty"`
+	// From 0 to 100, harder questions weigh more and few answers keep it low.
+	Score uint32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *Mastery) Reset() {
+	*x = Mastery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_profile_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mastery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mastery) ProtoMessage() {}
+
+func (x *Mastery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_profile_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mastery.ProtoReflect.Descriptor instead.
+func (*Mastery) Descriptor() ([]byte, []int) {
+	return file_api_v1_profile_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Mastery) GetLanguage() quiz.Language {
+	if x != nil {
+		return x.Language
+	}
+	return quiz.Language(0)
+}
+
+func (x *Mastery) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Mastery) GetAnswered() uint32 {
+	if x != nil {
+		return x.Answered
+	}
+	return 0
+}
+
+func (x *Mastery) GetCorrect() uint32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *Mastery) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetProfile_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetProfile_Request) Reset() {
+	*x = GetProfile_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_profile_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfile_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfile_Request) ProtoMessage() {}
+
+func (x *GetProfile_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_profile_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfile_Request.ProtoReflect.Descriptor instead.
+func (*GetProfile_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_profile_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type GetProfile_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GetProfile_Response) Reset() {
+	*x = GetProfile_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_profile_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfile_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfile_Response) ProtoMessage() {}
+
+func (x *GetProfile_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_profile_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfile_Response.ProtoReflect.Descriptor instead.
+func (*GetProfile_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_profile_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *GetProfile_Response) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+var File_api_v1_profile_service_proto protoreflect.FileDescriptor
+
+var file_api_v1_profile_service_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75,
+	0x69, 0x7a, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x4f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x09,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x22, 0xa8, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x78, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x58, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x78, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x58, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x22, 0x97, 0x01, 0x0a,
+	0x07, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69,
+	0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x32, 0x68, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x5c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x2d, 0x77, 0x61, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x3b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1_profile_service_proto_rawDescOnce sync.Once
+	file_api_v1_profile_service_proto_rawDescData = file_api_v1_profile_service_proto_rawDesc
+)
+
+func file_api_v1_profile_service_proto_rawDescGZIP() []byte {
+	file_api_v1_profile_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_profile_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_profile_service_proto_rawDescData)
+	})
+	return file_api_v1_profile_service_proto_rawDescData
+}
+
+var file_api_v1_profile_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_profile_service_proto_goTypes = []interface{}{
+	(*GetProfile)(nil),          // 0: profile.GetProfile
+	(*Profile)(nil),             // 1: profile.Profile
+	(*Mastery)(nil),             // 2: profile.Mastery
+	(*GetProfile_Request)(nil),  // 3: profile.GetProfile.Request
+	(*GetProfile_Response)(nil), // 4: profile.GetProfile.Response
+	(quiz.Language)(0),          // 5: quiz.Language
+}
+var file_api_v1_profile_service_proto_depIdxs = []int32{
+	2, // 0: profile.Profile.mastery:type_name -> profile.Mastery
+	5, // 1: profile.Mastery.language:type_name -> quiz.Language
+	1, // 2: profile.GetProfile.Response.profile:type_name -> profile.Profile
+	3, // 3: profile.Profiles.GetProfile:input_type -> profile.GetProfile.Request
+	4, // 4: profile.Profiles.GetProfile:output_type -> profile.GetProfile.Response
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_profile_service_proto_init() }
+func file_api_v1_profile_service_proto_init() {
+	if File_api_v1_profile_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v1_profile_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_profile_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_profile_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mastery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_profile_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfile_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_profile_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfile_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_v1_profile_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_profile_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_profile_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_profile_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_profile_service_proto = out.File
+	file_api_v1_profile_service_proto_rawDesc = nil
+	file_api_v1_profile_service_proto_goTypes = nil
+	file_api_v1_profile_service_proto_depIdxs = nil
+}
@@ -0,0 +1,630 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/v1/profile/service.proto
+
+package profile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = quiz.Language(0)
+)
+
+// Validate checks the field values on GetProfile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetProfileMultiError, or
+// nil if none found.
+func (m *GetProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileMultiError is an error wrapping multiple validation errors
+// returned by GetProfile.ValidateAll() if the designated constraints aren't met.
+type GetProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileMultiError) AllErrors() []error { return m }
+
+// GetProfileValidationError is the validation error returned by
+// GetProfile.Validate if the designated constraints aren't met.
+type GetProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileValidationError) ErrorName() string { return "GetProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileValidationError{}
+
+// Validate checks the field values on Profile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Profile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Profile with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProfileMultiError, or nil if none found.
+func (m *Profile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Profile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Xp
+
+	// no validation rules for Level
+
+	// no validation rules for LevelXp
+
+	// no validation rules for NextLevelXp
+
+	// no validation rules for Answered
+
+	// no validation rules for Correct
+
+	// no validation rules for Accuracy
+
+	for idx, item := range m.GetMastery() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProfileValidationError{
+						field:  fmt.Sprintf("Mastery[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProfileValidationError{
+						field:  fmt.Sprintf("Mastery[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProfileValidationError{
+					field:  fmt.Sprintf("Mastery[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileMultiError is an error wrapping multiple validation errors returned
+// by Profile.ValidateAll() if the designated constraints aren't met.
+type ProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileMultiError) AllErrors() []error { return m }
+
+// ProfileValidationError is the validation error returned by Profile.Validate
+// if the designated constraints aren't met.
+type ProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileValidationError) ErrorName() string { return "ProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileValidationError{}
+
+// Validate checks the field values on Mastery with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Mastery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Mastery with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MasteryMultiError, or nil if none found.
+func (m *Mastery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Mastery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Language
+
+	// no validation rules for Topic
+
+	// no validation rules for Answered
+
+	// no validation rules for Correct
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return MasteryMultiError(errors)
+	}
+
+	return nil
+}
+
+// MasteryMultiError is an error wrapping multiple validation errors returned
+// by Mastery.ValidateAll() if the designated constraints aren't met.
+type MasteryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MasteryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MasteryMultiError) AllErrors() []error { return m }
+
+// MasteryValidationError is the validation error returned by Mastery.Validate
+// if the designated constraints aren't met.
+type MasteryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MasteryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MasteryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MasteryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MasteryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MasteryValidationError) ErrorName() string { return "MasteryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MasteryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMastery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MasteryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MasteryValidationError{}
+
+// Validate checks the field values on GetProfile_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfile_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfile_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfile_RequestMultiError, or nil if none found.
+func (m *GetProfile_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfile_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetProfile_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfile_RequestMultiError is an error wrapping multiple validation errors
+// returned by GetProfile_Request.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfile_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfile_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfile_RequestMultiError) AllErrors() []error { return m }
+
+// GetProfile_RequestValidationError is the validation error returned by
+// GetProfile_Request.Validate if the designated constraints aren't met.
+type GetProfile_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfile_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfile_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfile_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfile_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfile_RequestValidationError) ErrorName() string {
+	return "GetProfile_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfile_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfile_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfile_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfile_RequestValidationError{}
+
+// Validate checks the field values on GetProfile_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfile_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfile_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfile_ResponseMultiError, or nil if none found.
+func (m *GetProfile_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfile_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProfile_ResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProfile_ResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProfile_ResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProfile_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfile_ResponseMultiError is an error wrapping multiple validation
+// errors returned by GetProfile_Response.ValidateAll() if the designated
+// constraints aren't met.
+type GetProfile_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfile_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfile_ResponseMultiError) AllErrors() []error { return m }
+
+// GetProfile_ResponseValidationError is the validation error returned by
+// GetProfile_Response.Validate if the designated constraints aren't met.
+type GetProfile_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfile_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfile_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfile_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfile_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfile_ResponseValidationError) ErrorName() string {
+	return "GetProfile_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfile_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfile_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfile_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfile_ResponseValidationError{}
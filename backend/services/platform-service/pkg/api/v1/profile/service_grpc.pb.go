@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: api/v1/profile/service.proto
+
+package profile
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProfilesClient is the client API for Profiles service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProfilesClient interface {
+	GetProfile(ctx context.Context, in *GetProfile_Request, opts ...grpc.CallOption) (*GetProfile_Response, error)
+}
+
+type profilesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfilesClient(cc grpc.ClientConnInterface) ProfilesClient {
+	return &profilesClient{cc}
+}
+
+func (c *profilesClient) GetProfile(ctx context.Context, in *GetProfile_Request, opts ...grpc.CallOption) (*GetProfile_Response, error) {
+	out := new(GetProfile_Response)
+	err := c.cc.Invoke(ctx, "/profile.Profiles/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfilesServer is the server API for Profiles service.
+// All implementations must embed UnimplementedProfilesServer
+// for forward compatibility
+type ProfilesServer interface {
+	GetProfile(context.Context, *GetProfile_Request) (*GetProfile_Response, error)
+	mustEmbedUnimplementedProfilesServer()
+}
+
+// UnimplementedProfilesServer must be embedded to have forward compatible implementations.
+type UnimplementedProfilesServer struct {
+}
+
+func (UnimplementedProfilesServer) GetProfile(context.Context, *GetProfile_Request) (*GetProfile_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedProfilesServer) mustEmbedUnimplementedProfilesServer() {}
+
+// UnsafeProfilesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfilesServer will
+// result in compilation errors.
+type UnsafeProfilesServer interface {
+	mustEmbedUnimplementedProfilesServer()
+}
+
+func RegisterProfilesServer(s grpc.ServiceRegistrar, srv ProfilesServer) {
+	s.RegisterService(&Profiles_ServiceDesc, srv)
+}
+
+func _Profiles_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfile_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profiles/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).GetProfile(ctx, req.(*GetProfile_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Profiles_ServiceDesc is the grpc.ServiceDesc for Profiles service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Profiles_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "profile.Profiles",
+	HandlerType: (*ProfilesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfile",
+			Handler:    _Profiles_GetProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/profile/service.proto",
+}
@@ -11,7 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -139,6 +139,58 @@ func (Difficulty) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1}
 }
 
+type AnswerType int32
+
+const (
+	AnswerType_ANSWER_TYPE_UNSPECIFIED     AnswerType = 0
+	AnswerType_ANSWER_TYPE_MULTIPLE_CHOICE AnswerType = 1
+	AnswerType_ANSWER_TYPE_FREE_TEXT       AnswerType = 2
+	AnswerType_ANSWER_TYPE_MIXED           AnswerType = 3
+)
+
+// Enum value maps for AnswerType.
+var (
+	AnswerType_name = map[int32]string{
+		0: "ANSWER_TYPE_UNSPECIFIED",
+		1: "ANSWER_TYPE_MULTIPLE_CHOICE",
+		2: "ANSWER_TYPE_FREE_TEXT",
+		3: "ANSWER_TYPE_MIXED",
+	}
+	AnswerType_value = map[string]int32{
+		"ANSWER_TYPE_UNSPECIFIED":     0,
+		"ANSWER_TYPE_MULTIPLE_CHOICE": 1,
+		"ANSWER_TYPE_FREE_TEXT":       2,
+		"ANSWER_TYPE_MIXED":           3,
+	}
+)
+
+func (x AnswerType) Enum() *AnswerType {
+	p := new(AnswerType)
+	*p = x
+	return p
+}
+
+func (x AnswerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnswerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_quiz_service_proto_enumTypes[2].Descriptor()
+}
+
+func (AnswerType) Type() protoreflect.EnumType {
+	return &file_api_v1_quiz_service_proto_enumTypes[2]
+}
+
+func (x AnswerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnswerType.Descriptor instead.
+func (AnswerType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{2}
+}
+
 type ListQuestions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -177,28 +229,273 @@ func (*ListQuestions) Descriptor() ([]byte, []int) {
 	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{0}
 }
 
+type SubmitAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitAnswer) Reset() {
+	*x = SubmitAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer) ProtoMessage() {}
+
+func (x *SubmitAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListLanguages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLanguages) Reset() {
+	*x = ListLanguages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLanguages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLanguages) ProtoMessage() {}
+
+func (x *ListLanguages) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLanguages.ProtoReflect.Descriptor instead.
+func (*ListLanguages) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ListTopics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTopics) Reset() {
+	*x = ListTopics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopics) ProtoMessage() {}
+
+func (x *ListTopics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopics.ProtoReflect.Descriptor instead.
+func (*ListTopics) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{3}
+}
+
+type LanguageInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language     Language     `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	DisplayName  string       `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Difficulties []Difficulty `protobuf:"varint,3,rep,packed,name=difficulties,proto3,enum=quiz.Difficulty" json:"difficulties,omitempty"`
+}
+
+func (x *LanguageInfo) Reset() {
+	*x = LanguageInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LanguageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageInfo) ProtoMessage() {}
+
+func (x *LanguageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguageInfo.ProtoReflect.Descriptor instead.
+func (*LanguageInfo) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LanguageInfo) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_UNSPECIFIED
+}
+
+func (x *LanguageInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *LanguageInfo) GetDifficulties() []Difficulty {
+	if x != nil {
+		return x.Difficulties
+	}
+	return nil
+}
+
+type Topic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName  string       `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Difficulties []Difficulty `protobuf:"varint,3,rep,packed,name=difficulties,proto3,enum=quiz.Difficulty" json:"difficulties,omitempty"`
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+func (x *Topic) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Topic) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Topic) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Topic) GetDifficulties() []Difficulty {
+	if x != nil {
+		return x.Difficulties
+	}
+	return nil
+}
+
 type Question struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Language    Language          `protobuf:"varint,2,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
-	Topic       string            `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
-	Difficulty  Difficulty        `protobuf:"varint,4,opt,name=difficulty,proto3,enum=quiz.Difficulty" json:"difficulty,omitempty"`
-	Content     *Question_Content `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Explanation string            `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Id         string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Language   Language          `protobuf:"varint,2,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	Topic      string            `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	Difficulty Difficulty        `protobuf:"varint,4,opt,name=difficulty,proto3,enum=quiz.Difficulty" json:"difficulty,omitempty"`
+	Content    *Question_Content `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// Not set, the explanation gives the answer away and is sent with the
+	// answer result instead.
+	Explanation string `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
 	// Types that are assignable to Answer:
 	//
 	//	*Question_MultipleChoice
 	//	*Question_FreeText
 	Answer isQuestion_Answer `protobuf_oneof:"Answer"`
+	// Answers received after the deadline get no points. Not set for questions
+	// without a time limit.
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
 func (x *Question) Reset() {
 	*x = Question{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_quiz_service_proto_msgTypes[1]
+		mi := &file_api_v1_quiz_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -211,7 +508,7 @@ func (x *Question) String() string {
 func (*Question) ProtoMessage() {}
 
 func (x *Question) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_quiz_service_proto_msgTypes[1]
+	mi := &file_api_v1_quiz_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,7 +521,7 @@ func (x *Question) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Question.ProtoReflect.Descriptor instead.
 func (*Question) Descriptor() ([]byte, []int) {
-	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1}
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Question) GetId() string {
@@ -262,78 +559,574 @@ func (x *Question) GetContent() *Question_Content {
 	return nil
 }
 
-func (x *Question) GetExplanation() string {
+func (x *Question) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (m *Question) GetAnswer() isQuestion_Answer {
+	if m != nil {
+		return m.Answer
+	}
+	return nil
+}
+
+func (x *Question) GetMultipleChoice() *Question_MultipleChoiceAnswer {
+	if x, ok := x.GetAnswer().(*Question_MultipleChoice); ok {
+		return x.MultipleChoice
+	}
+	return nil
+}
+
+func (x *Question) GetFreeText() *Question_FreeTextAnswer {
+	if x, ok := x.GetAnswer().(*Question_FreeText); ok {
+		return x.FreeText
+	}
+	return nil
+}
+
+func (x *Question) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type isQuestion_Answer interface {
+	isQuestion_Answer()
+}
+
+type Question_MultipleChoice struct {
+	MultipleChoice *Question_MultipleChoiceAnswer `protobuf:"bytes,7,opt,name=multiple_choice,json=multipleChoice,proto3,oneof"`
+}
+
+type Question_FreeText struct {
+	FreeText *Question_FreeTextAnswer `protobuf:"bytes,8,opt,name=free_text,json=freeText,proto3,oneof"`
+}
+
+func (*Question_MultipleChoice) isQuestion_Answer() {}
+
+func (*Question_FreeText) isQuestion_Answer() {}
+
+type ListQuestions_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language   Language   `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	Topics     []string   `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Difficulty Difficulty `protobuf:"varint,3,opt,name=difficulty,proto3,enum=quiz.Difficulty" json:"difficulty,omitempty"`
+	Limit      uint32     `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	AnswerType AnswerType `protobuf:"varint,5,opt,name=answer_type,json=answerType,proto3,enum=quiz.AnswerType" json:"answer_type,omitempty"`
+}
+
+func (x *ListQuestions_Request) Reset() {
+	*x = ListQuestions_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQuestions_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestions_Request) ProtoMessage() {}
+
+func (x *ListQuestions_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestions_Request.ProtoReflect.Descriptor instead.
+func (*ListQuestions_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ListQuestions_Request) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_UNSPECIFIED
+}
+
+func (x *ListQuestions_Request) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *ListQuestions_Request) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *ListQuestions_Request) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListQuestions_Request) GetAnswerType() AnswerType {
+	if x != nil {
+		return x.AnswerType
+	}
+	return AnswerType_ANSWER_TYPE_UNSPECIFIED
+}
+
+type ListQuestions_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions []*Question `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+}
+
+func (x *ListQuestions_Response) Reset() {
+	*x = ListQuestions_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQuestions_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestions_Response) ProtoMessage() {}
+
+func (x *ListQuestions_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestions_Response.ProtoReflect.Descriptor instead.
+func (*ListQuestions_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ListQuestions_Response) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type SubmitAnswer_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// Types that are assignable to Answer:
+	//
+	//	*SubmitAnswer_Request_MultipleChoice
+	//	*SubmitAnswer_Request_FreeText
+	Answer isSubmitAnswer_Request_Answer `protobuf_oneof:"answer"`
+}
+
+func (x *SubmitAnswer_Request) Reset() {
+	*x = SubmitAnswer_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer_Request) ProtoMessage() {}
+
+func (x *SubmitAnswer_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer_Request.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SubmitAnswer_Request) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (m *SubmitAnswer_Request) GetAnswer() isSubmitAnswer_Request_Answer {
+	if m != nil {
+		return m.Answer
+	}
+	return nil
+}
+
+func (x *SubmitAnswer_Request) GetMultipleChoice() *SubmitAnswer_Request_MultipleChoiceAnswer {
+	if x, ok := x.GetAnswer().(*SubmitAnswer_Request_MultipleChoice); ok {
+		return x.MultipleChoice
+	}
+	return nil
+}
+
+func (x *SubmitAnswer_Request) GetFreeText() *SubmitAnswer_Request_FreeTextAnswer {
+	if x, ok := x.GetAnswer().(*SubmitAnswer_Request_FreeText); ok {
+		return x.FreeText
+	}
+	return nil
+}
+
+type isSubmitAnswer_Request_Answer interface {
+	isSubmitAnswer_Request_Answer()
+}
+
+type SubmitAnswer_Request_MultipleChoice struct {
+	MultipleChoice *SubmitAnswer_Request_MultipleChoiceAnswer `protobuf:"bytes,2,opt,name=multiple_choice,json=multipleChoice,proto3,oneof"`
+}
+
+type SubmitAnswer_Request_FreeText struct {
+	FreeText *SubmitAnswer_Request_FreeTextAnswer `protobuf:"bytes,3,opt,name=free_text,json=freeText,proto3,oneof"`
+}
+
+func (*SubmitAnswer_Request_MultipleChoice) isSubmitAnswer_Request_Answer() {}
+
+func (*SubmitAnswer_Request_FreeText) isSubmitAnswer_Request_Answer() {}
+
+type SubmitAnswer_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Correct        bool     `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	CorrectAnswers []string `protobuf:"bytes,2,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	Explanation    string   `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// Points including the speed bonus.
+	Points     uint32 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Late       bool   `protobuf:"varint,5,opt,name=late,proto3" json:"late,omitempty"`
+	SpeedBonus uint32 `protobuf:"varint,6,opt,name=speed_bonus,json=speedBonus,proto3" json:"speed_bonus,omitempty"`
+}
+
+func (x *SubmitAnswer_Response) Reset() {
+	*x = SubmitAnswer_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer_Response) ProtoMessage() {}
+
+func (x *SubmitAnswer_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer_Response.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *SubmitAnswer_Response) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *SubmitAnswer_Response) GetCorrectAnswers() []string {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return nil
+}
+
+func (x *SubmitAnswer_Response) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *SubmitAnswer_Response) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *SubmitAnswer_Response) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *SubmitAnswer_Response) GetSpeedBonus() uint32 {
+	if x != nil {
+		return x.SpeedBonus
+	}
+	return 0
+}
+
+type SubmitAnswer_Request_MultipleChoiceAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SelectedOptions []string `protobuf:"bytes,1,rep,name=selected_options,json=selectedOptions,proto3" json:"selected_options,omitempty"`
+}
+
+func (x *SubmitAnswer_Request_MultipleChoiceAnswer) Reset() {
+	*x = SubmitAnswer_Request_MultipleChoiceAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer_Request_MultipleChoiceAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer_Request_MultipleChoiceAnswer) ProtoMessage() {}
+
+func (x *SubmitAnswer_Request_MultipleChoiceAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer_Request_MultipleChoiceAnswer.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer_Request_MultipleChoiceAnswer) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *SubmitAnswer_Request_MultipleChoiceAnswer) GetSelectedOptions() []string {
+	if x != nil {
+		return x.SelectedOptions
+	}
+	return nil
+}
+
+type SubmitAnswer_Request_FreeTextAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SubmitAnswer_Request_FreeTextAnswer) Reset() {
+	*x = SubmitAnswer_Request_FreeTextAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAnswer_Request_FreeTextAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswer_Request_FreeTextAnswer) ProtoMessage() {}
+
+func (x *SubmitAnswer_Request_FreeTextAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswer_Request_FreeTextAnswer.ProtoReflect.Descriptor instead.
+func (*SubmitAnswer_Request_FreeTextAnswer) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1, 0, 1}
+}
+
+func (x *SubmitAnswer_Request_FreeTextAnswer) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ListLanguages_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ListLanguages_Request) Reset() {
+	*x = ListLanguages_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLanguages_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLanguages_Request) ProtoMessage() {}
+
+func (x *ListLanguages_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLanguages_Request.ProtoReflect.Descriptor instead.
+func (*ListLanguages_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *ListLanguages_Request) GetLocale() string {
 	if x != nil {
-		return x.Explanation
+		return x.Locale
 	}
 	return ""
 }
 
-func (m *Question) GetAnswer() isQuestion_Answer {
-	if m != nil {
-		return m.Answer
-	}
-	return nil
-}
+type ListLanguages_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Question) GetMultipleChoice() *Question_MultipleChoiceAnswer {
-	if x, ok := x.GetAnswer().(*Question_MultipleChoice); ok {
-		return x.MultipleChoice
-	}
-	return nil
+	Languages []*LanguageInfo `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
 }
 
-func (x *Question) GetFreeText() *Question_FreeTextAnswer {
-	if x, ok := x.GetAnswer().(*Question_FreeText); ok {
-		return x.FreeText
+func (x *ListLanguages_Response) Reset() {
+	*x = ListLanguages_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_quiz_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isQuestion_Answer interface {
-	isQuestion_Answer()
+func (x *ListLanguages_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Question_MultipleChoice struct {
-	MultipleChoice *Question_MultipleChoiceAnswer `protobuf:"bytes,7,opt,name=multiple_choice,json=multipleChoice,proto3,oneof"`
-}
+func (*ListLanguages_Response) ProtoMessage() {}
 
-type Question_FreeText struct {
-	FreeText *Question_FreeTextAnswer `protobuf:"bytes,8,opt,name=free_text,json=freeText,proto3,oneof"`
+func (x *ListLanguages_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*Question_MultipleChoice) isQuestion_Answer() {}
+// Deprecated: Use ListLanguages_Response.ProtoReflect.Descriptor instead.
+func (*ListLanguages_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{2, 1}
+}
 
-func (*Question_FreeText) isQuestion_Answer() {}
+func (x *ListLanguages_Response) GetLanguages() []*LanguageInfo {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
 
-type ListQuestions_Request struct {
+type ListTopics_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Language   Language   `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
-	Topics     []string   `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
-	Difficulty Difficulty `protobuf:"varint,3,opt,name=difficulty,proto3,enum=quiz.Difficulty" json:"difficulty,omitempty"`
-	Limit      uint32     `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Language Language `protobuf:"varint,1,opt,name=language,proto3,enum=quiz.Language" json:"language,omitempty"`
+	Locale   string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
-func (x *ListQuestions_Request) Reset() {
-	*x = ListQuestions_Request{}
+func (x *ListTopics_Request) Reset() {
+	*x = ListTopics_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_quiz_service_proto_msgTypes[2]
+		mi := &file_api_v1_quiz_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListQuestions_Request) String() string {
+func (x *ListTopics_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQuestions_Request) ProtoMessage() {}
+func (*ListTopics_Request) ProtoMessage() {}
 
-func (x *ListQuestions_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_quiz_service_proto_msgTypes[2]
+func (x *ListTopics_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,64 +1137,50 @@ func (x *ListQuestions_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQuestions_Request.ProtoReflect.Descriptor instead.
-func (*ListQuestions_Request) Descriptor() ([]byte, []int) {
-	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use ListTopics_Request.ProtoReflect.Descriptor instead.
+func (*ListTopics_Request) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{3, 0}
 }
 
-func (x *ListQuestions_Request) GetLanguage() Language {
+func (x *ListTopics_Request) GetLanguage() Language {
 	if x != nil {
 		return x.Language
 	}
 	return Language_LANGUAGE_UNSPECIFIED
 }
 
-func (x *ListQuestions_Request) GetTopics() []string {
-	if x != nil {
-		return x.Topics
-	}
-	return nil
-}
-
-func (x *ListQuestions_Request) GetDifficulty() Difficulty {
-	if x != nil {
-		return x.Difficulty
-	}
-	return Difficulty_DIFFICULTY_UNSPECIFIED
-}
-
-func (x *ListQuestions_Request) GetLimit() uint32 {
+func (x *ListTopics_Request) GetLocale() string {
 	if x != nil {
-		return x.Limit
+		return x.Locale
 	}
-	return 0
+	return ""
 }
 
-type ListQuestions_Response struct {
+type ListTopics_Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Questions []*Question `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
 
-func (x *ListQuestions_Response) Reset() {
-	*x = ListQuestions_Response{}
+func (x *ListTopics_Response) Reset() {
+	*x = ListTopics_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_quiz_service_proto_msgTypes[3]
+		mi := &file_api_v1_quiz_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListQuestions_Response) String() string {
+func (x *ListTopics_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQuestions_Response) ProtoMessage() {}
+func (*ListTopics_Response) ProtoMessage() {}
 
-func (x *ListQuestions_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_quiz_service_proto_msgTypes[3]
+func (x *ListTopics_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,14 +1191,14 @@ func (x *ListQuestions_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQuestions_Response.ProtoReflect.Descriptor instead.
-func (*ListQuestions_Response) Descriptor() ([]byte, []int) {
-	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use ListTopics_Response.ProtoReflect.Descriptor instead.
+func (*ListTopics_Response) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{3, 1}
 }
 
-func (x *ListQuestions_Response) GetQuestions() []*Question {
+func (x *ListTopics_Response) GetTopics() []*Topic {
 	if x != nil {
-		return x.Questions
+		return x.Topics
 	}
 	return nil
 }
@@ -436,7 +1215,7 @@ type Question_Content struct {
 func (x *Question_Content) Reset() {
 	*x = Question_Content{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_quiz_service_proto_msgTypes[4]
+		mi := &file_api_v1_quiz_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -449,7 +1228,7 @@ func (x *Question_Content) String() string {
 func (*Question_Content) ProtoMessage() {}
 
 func (x *Question_Content) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_quiz_service_proto_msgTypes[4]
+	mi := &file_api_v1_quiz_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +1241,7 @@ func (x *Question_Content) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Question_Content.ProtoReflect.Descriptor instead.
 func (*Question_Content) Descriptor() ([]byte, []int) {
-	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1, 0}
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *Question_Content) GetText() string {
@@ -484,14 +1263,13 @@ type Question_MultipleChoiceAnswer struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Options        []string `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
-	CorrectOptions []string `protobuf:"bytes,4,rep,name=correct_options,json=correctOptions,proto3" json:"correct_options,omitempty"`
+	Options []string `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
 }
 
 func (x *Question_MultipleChoiceAnswer) Reset() {
 	*x = Question_MultipleChoiceAnswer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_quiz_service_proto_msgTypes[5]
+		mi := &file_api_v1_quiz_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -504,7 +1282,7 @@ func (x *Question_MultipleChoiceAnswer) String() string {
 func (*Question_MultipleChoiceAnswer) ProtoMessage() {}
 
 func (x *Question_MultipleChoiceAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_quiz_service_proto_msgTypes[5]
+	mi := &file_api_v1_quiz_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +1295,7 @@ func (x *Question_MultipleChoiceAnswer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Question_MultipleChoiceAnswer.ProtoReflect.Descriptor instead.
 func (*Question_MultipleChoiceAnswer) Descriptor() ([]byte, []int) {
-	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1, 1}
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *Question_MultipleChoiceAnswer) GetOptions() []string {
@@ -527,25 +1305,16 @@ func (x *Question_MultipleChoiceAnswer) GetOptions() []string {
 	return nil
 }
 
-func (x *Question_MultipleChoiceAnswer) GetCorrectOptions() []string {
-	if x != nil {
-		return x.CorrectOptions
-	}
-	return nil
-}
-
 type Question_FreeTextAnswer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CorrectAnswers []string `protobuf:"bytes,1,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
 }
 
 func (x *Question_FreeTextAnswer) Reset() {
 	*x = Question_FreeTextAnswer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_quiz_service_proto_msgTypes[6]
+		mi := &file_api_v1_quiz_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -558,7 +1327,7 @@ func (x *Question_FreeTextAnswer) String() string {
 func (*Question_FreeTextAnswer) ProtoMessage() {}
 
 func (x *Question_FreeTextAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_quiz_service_proto_msgTypes[6]
+	mi := &file_api_v1_quiz_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,14 +1340,7 @@ func (x *Question_FreeTextAnswer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Question_FreeTextAnswer.ProtoReflect.Descriptor instead.
 func (*Question_FreeTextAnswer) Descriptor() ([]byte, []int) {
-	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{1, 2}
-}
-
-func (x *Question_FreeTextAnswer) GetCorrectAnswers() []string {
-	if x != nil {
-		return x.CorrectAnswers
-	}
-	return nil
+	return file_api_v1_quiz_service_proto_rawDescGZIP(), []int{6, 2}
 }
 
 var File_api_v1_quiz_service_proto protoreflect.FileDescriptor
@@ -591,8 +1353,8 @@ var file_api_v1_quiz_service_proto_rawDesc = []byte{
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x02, 0x0a, 0x0d, 0x4c, 0x69,
-	0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xc2, 0x01, 0x0a, 0x07,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x02, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xff, 0x01, 0x0a, 0x07,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
 	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a,
 	0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01,
@@ -605,77 +1367,181 @@ var file_api_v1_quiz_service_proto_rawDesc = []byte{
 	0x01, 0x20, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12,
 	0x1f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09,
 	0xfa, 0x42, 0x06, 0x2a, 0x04, 0x10, 0x0a, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x1a, 0x38, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd1, 0x04, 0x0a, 0x08, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a,
-	0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x69, 0x66,
-	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x71, 0x75, 0x69, 0x7a, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52,
-	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71,
-	0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x4e, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69,
-	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
-	0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52,
-	0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12,
-	0x3c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x48, 0x00, 0x52, 0x08, 0x66, 0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x1a, 0x3f, 0x0a,
-	0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x17, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0x59,
-	0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
-	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65,
-	0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0e, 0x46, 0x72, 0x65,
-	0x65, 0x54, 0x65, 0x78, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2a, 0xb4,
-	0x01, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4c,
-	0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47,
-	0x45, 0x5f, 0x50, 0x59, 0x54, 0x48, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x41,
-	0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x4a, 0x41, 0x56, 0x41, 0x53, 0x43, 0x52, 0x49, 0x50,
-	0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f,
-	0x47, 0x4f, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45,
-	0x5f, 0x4a, 0x41, 0x56, 0x41, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x41, 0x4e, 0x47, 0x55,
-	0x41, 0x47, 0x45, 0x5f, 0x43, 0x50, 0x50, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x4e,
-	0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x55, 0x53, 0x54, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13,
-	0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x53, 0x43, 0x52,
-	0x49, 0x50, 0x54, 0x10, 0x07, 0x2a, 0x77, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
-	0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54,
-	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x17, 0x0a, 0x13, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x42, 0x45,
-	0x47, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x46, 0x46,
-	0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49,
-	0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55,
-	0x4c, 0x54, 0x59, 0x5f, 0x41, 0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x03, 0x32, 0x6e,
-	0x0a, 0x04, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x66, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f,
-	0x71, 0x75, 0x69, 0x7a, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x37,
-	0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x73,
-	0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x2d, 0x77,
-	0x61, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75,
-	0x69, 0x7a, 0x3b, 0x71, 0x75, 0x69, 0x7a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x38, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71,
+	0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb4, 0x04, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a, 0xe4, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x5a,
+	0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x66, 0x72,
+	0x65, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x71, 0x75, 0x69, 0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65,
+	0x78, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x66, 0x72, 0x65, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x1a, 0x4b, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x10,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01,
+	0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x2d, 0x0a, 0x0e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x42, 0x0d, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x1a,
+	0xbc, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x22, 0x70,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x1a,
+	0x21, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x1a, 0x3c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x98, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x1a,
+	0x59, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71,
+	0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x1a, 0x2f, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x0c,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x52, 0x0c, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x70, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x0c, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0c, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0xe5, 0x04, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x65,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x71, 0x75,
+	0x69, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x72, 0x65, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x66, 0x72,
+	0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x3f,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x17, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x1a,
+	0x47, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x27, 0x0a, 0x0e, 0x46, 0x72, 0x65, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x52, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x42, 0x08, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2a, 0xb4, 0x01, 0x0a, 0x08,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x41, 0x4e, 0x47,
+	0x55, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x50,
+	0x59, 0x54, 0x48, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x41, 0x4e, 0x47, 0x55,
+	0x41, 0x47, 0x45, 0x5f, 0x4a, 0x41, 0x56, 0x41, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x47, 0x4f, 0x10,
+	0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x4a, 0x41,
+	0x56, 0x41, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45,
+	0x5f, 0x43, 0x50, 0x50, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41,
+	0x47, 0x45, 0x5f, 0x52, 0x55, 0x53, 0x54, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x41, 0x4e,
+	0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54,
+	0x10, 0x07, 0x2a, 0x77, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x42, 0x45, 0x47, 0x49, 0x4e,
+	0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55,
+	0x4c, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59,
+	0x5f, 0x41, 0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x7c, 0x0a, 0x0a, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x4e, 0x53,
+	0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x4e, 0x53, 0x57, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x45, 0x5f, 0x43,
+	0x48, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x4e, 0x53, 0x57, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54,
+	0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4e, 0x53, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4d, 0x49, 0x58, 0x45, 0x44, 0x10, 0x03, 0x32, 0xc4, 0x03, 0x0a, 0x04, 0x51, 0x75,
+	0x69, 0x7a, 0x12, 0x66, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x69, 0x7a,
+	0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7b, 0x0a, 0x0c, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x71, 0x75, 0x69,
+	0x7a, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x22, 0x27,
+	0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x69, 0x7a, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31,
+	0x2f, 0x71, 0x75, 0x69, 0x7a, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x6f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x18, 0x2e,
+	0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f,
+	0x71, 0x75, 0x69, 0x7a, 0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x7d, 0x2f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x61, 0x73, 0x6e, 0x65, 0x72, 0x61, 0x6e, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x2d, 0x77, 0x61, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x71, 0x75, 0x69, 0x7a, 0x3b, 0x71, 0x75, 0x69, 0x7a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -690,35 +1556,66 @@ func file_api_v1_quiz_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_quiz_service_proto_rawDescData
 }
 
-var file_api_v1_quiz_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_quiz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_quiz_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_quiz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_api_v1_quiz_service_proto_goTypes = []interface{}{
-	(Language)(0),                         // 0: quiz.Language
-	(Difficulty)(0),                       // 1: quiz.Difficulty
-	(*ListQuestions)(nil),                 // 2: quiz.ListQuestions
-	(*Question)(nil),                      // 3: quiz.Question
-	(*ListQuestions_Request)(nil),         // 4: quiz.ListQuestions.Request
-	(*ListQuestions_Response)(nil),        // 5: quiz.ListQuestions.Response
-	(*Question_Content)(nil),              // 6: quiz.Question.Content
-	(*Question_MultipleChoiceAnswer)(nil), // 7: quiz.Question.MultipleChoiceAnswer
-	(*Question_FreeTextAnswer)(nil),       // 8: quiz.Question.FreeTextAnswer
+	(Language)(0),                                     // 0: quiz.Language
+	(Difficulty)(0),                                   // 1: quiz.Difficulty
+	(AnswerType)(0),                                   // 2: quiz.AnswerType
+	(*ListQuestions)(nil),                             // 3: quiz.ListQuestions
+	(*SubmitAnswer)(nil),                              // 4: quiz.SubmitAnswer
+	(*ListLanguages)(nil),                             // 5: quiz.ListLanguages
+	(*ListTopics)(nil),                                // 6: quiz.ListTopics
+	(*LanguageInfo)(nil),                              // 7: quiz.LanguageInfo
+	(*Topic)(nil),                                     // 8: quiz.Topic
+	(*Question)(nil),                                  // 9: quiz.Question
+	(*ListQuestions_Request)(nil),                     // 10: quiz.ListQuestions.Request
+	(*ListQuestions_Response)(nil),                    // 11: quiz.ListQuestions.Response
+	(*SubmitAnswer_Request)(nil),                      // 12: quiz.SubmitAnswer.Request
+	(*SubmitAnswer_Response)(nil),                     // 13: quiz.SubmitAnswer.Response
+	(*SubmitAnswer_Request_MultipleChoiceAnswer)(nil), // 14: quiz.SubmitAnswer.Request.MultipleChoiceAnswer
+	(*SubmitAnswer_Request_FreeTextAnswer)(nil),       // 15: quiz.SubmitAnswer.Request.FreeTextAnswer
+	(*ListLanguages_Request)(nil),                     // 16: quiz.ListLanguages.Request
+	(*ListLanguages_Response)(nil),                    // 17: quiz.ListLanguages.Response
+	(*ListTopics_Request)(nil),                        // 18: quiz.ListTopics.Request
+	(*ListTopics_Response)(nil),                       // 19: quiz.ListTopics.Response
+	(*Question_Content)(nil),                          // 20: quiz.Question.Content
+	(*Question_MultipleChoiceAnswer)(nil),             // 21: quiz.Question.MultipleChoiceAnswer
+	(*Question_FreeTextAnswer)(nil),                   // 22: quiz.Question.FreeTextAnswer
+	(*timestamppb.Timestamp)(nil),                     // 23: google.protobuf.Timestamp
 }
 var file_api_v1_quiz_service_proto_depIdxs = []int32{
-	0, // 0: quiz.Question.language:type_name -> quiz.Language
-	1, // 1: quiz.Question.difficulty:type_name -> quiz.Difficulty
-	6, // 2: quiz.Question.content:type_name -> quiz.Question.Content
-	7, // 3: quiz.Question.multiple_choice:type_name -> quiz.Question.MultipleChoiceAnswer
-	8, // 4: quiz.Question.free_text:type_name -> quiz.Question.FreeTextAnswer
-	0, // 5: quiz.ListQuestions.Request.language:type_name -> quiz.Language
-	1, // 6: quiz.ListQuestions.Request.difficulty:type_name -> quiz.Difficulty
-	3, // 7: quiz.ListQuestions.Response.questions:type_name -> quiz.Question
-	4, // 8: quiz.Quiz.ListQuestions:input_type -> quiz.ListQuestions.Request
-	5, // 9: quiz.Quiz.ListQuestions:output_type -> quiz.ListQuestions.Response
-	9, // [9:10] is the sub-list for method output_type
-	8, // [8:9] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: quiz.LanguageInfo.language:type_name -> quiz.Language
+	1,  // 1: quiz.LanguageInfo.difficulties:type_name -> quiz.Difficulty
+	1,  // 2: quiz.Topic.difficulties:type_name -> quiz.Difficulty
+	0,  // 3: quiz.Question.language:type_name -> quiz.Language
+	1,  // 4: quiz.Question.difficulty:type_name -> quiz.Difficulty
+	20, // 5: quiz.Question.content:type_name -> quiz.Question.Content
+	21, // 6: quiz.Question.multiple_choice:type_name -> quiz.Question.MultipleChoiceAnswer
+	22, // 7: quiz.Question.free_text:type_name -> quiz.Question.FreeTextAnswer
+	23, // 8: quiz.Question.deadline:type_name -> google.protobuf.Timestamp
+	0,  // 9: quiz.ListQuestions.Request.language:type_name -> quiz.Language
+	1,  // 10: quiz.ListQuestions.Request.difficulty:type_name -> quiz.Difficulty
+	2,  // 11: quiz.ListQuestions.Request.answer_type:type_name -> quiz.AnswerType
+	9,  // 12: quiz.ListQuestions.Response.questions:type_name -> quiz.Question
+	14, // 13: quiz.SubmitAnswer.Request.multiple_choice:type_name -> quiz.SubmitAnswer.Request.MultipleChoiceAnswer
+	15, // 14: quiz.SubmitAnswer.Request.free_text:type_name -> quiz.SubmitAnswer.Request.FreeTextAnswer
+	7,  // 15: quiz.ListLanguages.Response.languages:type_name -> quiz.LanguageInfo
+	0,  // 16: quiz.ListTopics.Request.language:type_name -> quiz.Language
+	8,  // 17: quiz.ListTopics.Response.topics:type_name -> quiz.Topic
+	10, // 18: quiz.Quiz.ListQuestions:input_type -> quiz.ListQuestions.Request
+	12, // 19: quiz.Quiz.SubmitAnswer:input_type -> quiz.SubmitAnswer.Request
+	16, // 20: quiz.Quiz.ListLanguages:input_type -> quiz.ListLanguages.Request
+	18, // 21: quiz.Quiz.ListTopics:input_type -> quiz.ListTopics.Request
+	11, // 22: quiz.Quiz.ListQuestions:output_type -> quiz.ListQuestions.Response
+	13, // 23: quiz.Quiz.SubmitAnswer:output_type -> quiz.SubmitAnswer.Response
+	17, // 24: quiz.Quiz.ListLanguages:output_type -> quiz.ListLanguages.Response
+	19, // 25: quiz.Quiz.ListTopics:output_type -> quiz.ListTopics.Response
+	22, // [22:26] is the sub-list for method output_type
+	18, // [18:22] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_quiz_service_proto_init() }
@@ -740,7 +1637,7 @@ func file_api_v1_quiz_service_proto_init() {
 			}
 		}
 		file_api_v1_quiz_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Question); i {
+			switch v := v.(*SubmitAnswer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -752,7 +1649,7 @@ func file_api_v1_quiz_service_proto_init() {
 			}
 		}
 		file_api_v1_quiz_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQuestions_Request); i {
+			switch v := v.(*ListLanguages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -764,7 +1661,7 @@ func file_api_v1_quiz_service_proto_init() {
 			}
 		}
 		file_api_v1_quiz_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQuestions_Response); i {
+			switch v := v.(*ListTopics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -776,7 +1673,7 @@ func file_api_v1_quiz_service_proto_init() {
 			}
 		}
 		file_api_v1_quiz_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Question_Content); i {
+			switch v := v.(*LanguageInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -788,7 +1685,7 @@ func file_api_v1_quiz_service_proto_init() {
 			}
 		}
 		file_api_v1_quiz_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Question_MultipleChoiceAnswer); i {
+			switch v := v.(*Topic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -800,6 +1697,162 @@ func file_api_v1_quiz_service_proto_init() {
 			}
 		}
 		file_api_v1_quiz_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Question); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQuestions_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQuestions_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer_Request_MultipleChoiceAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAnswer_Request_FreeTextAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLanguages_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLanguages_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopics_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopics_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Question_Content); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Question_MultipleChoiceAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_quiz_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Question_FreeTextAnswer); i {
 			case 0:
 				return &v.state
@@ -812,18 +1865,22 @@ func file_api_v1_quiz_service_proto_init() {
 			}
 		}
 	}
-	file_api_v1_quiz_service_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_api_v1_quiz_service_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*Question_MultipleChoice)(nil),
 		(*Question_FreeText)(nil),
 	}
-	file_api_v1_quiz_service_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_api_v1_quiz_service_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*SubmitAnswer_Request_MultipleChoice)(nil),
+		(*SubmitAnswer_Request_FreeText)(nil),
+	}
+	file_api_v1_quiz_service_proto_msgTypes[17].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_quiz_service_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
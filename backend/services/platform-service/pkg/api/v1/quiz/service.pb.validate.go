@@ -135,167 +135,1818 @@ var _ interface {
 	ErrorName() string
 } = ListQuestionsValidationError{}
 
+// Validate checks the field values on SubmitAnswer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubmitAnswerMultiError, or
+// nil if none found.
+func (m *SubmitAnswer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SubmitAnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswerMultiError is an error wrapping multiple validation errors
+// returned by SubmitAnswer.ValidateAll() if the designated constraints aren't met.
+type SubmitAnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswerMultiError) AllErrors() []error { return m }
+
+// SubmitAnswerValidationError is the validation error returned by
+// SubmitAnswer.Validate if the designated constraints aren't met.
+type SubmitAnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswerValidationError) ErrorName() string { return "SubmitAnswerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswerValidationError{}
+
+// Validate checks the field values on ListLanguages with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListLanguages) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLanguages with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListLanguagesMultiError, or
+// nil if none found.
+func (m *ListLanguages) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLanguages) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListLanguagesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLanguagesMultiError is an error wrapping multiple validation errors
+// returned by ListLanguages.ValidateAll() if the designated constraints
+// aren't met.
+type ListLanguagesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLanguagesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLanguagesMultiError) AllErrors() []error { return m }
+
+// ListLanguagesValidationError is the validation error returned by
+// ListLanguages.Validate if the designated constraints aren't met.
+type ListLanguagesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLanguagesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLanguagesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLanguagesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLanguagesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLanguagesValidationError) ErrorName() string { return "ListLanguagesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListLanguagesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLanguages.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLanguagesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLanguagesValidationError{}
+
+// Validate checks the field values on ListTopics with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTopics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTopics with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTopicsMultiError, or
+// nil if none found.
+func (m *ListTopics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTopics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTopicsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTopicsMultiError is an error wrapping multiple validation errors
+// returned by ListTopics.ValidateAll() if the designated constraints aren't met.
+type ListTopicsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTopicsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTopicsMultiError) AllErrors() []error { return m }
+
+// ListTopicsValidationError is the validation error returned by
+// ListTopics.Validate if the designated constraints aren't met.
+type ListTopicsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTopicsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTopicsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTopicsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTopicsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTopicsValidationError) ErrorName() string { return "ListTopicsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTopicsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTopics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTopicsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTopicsValidationError{}
+
+// Validate checks the field values on LanguageInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LanguageInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LanguageInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LanguageInfoMultiError, or
+// nil if none found.
+func (m *LanguageInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LanguageInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Language
+
+	// no validation rules for DisplayName
+
+	if len(errors) > 0 {
+		return LanguageInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// LanguageInfoMultiError is an error wrapping multiple validation errors
+// returned by LanguageInfo.ValidateAll() if the designated constraints aren't met.
+type LanguageInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LanguageInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LanguageInfoMultiError) AllErrors() []error { return m }
+
+// LanguageInfoValidationError is the validation error returned by
+// LanguageInfo.Validate if the designated constraints aren't met.
+type LanguageInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LanguageInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LanguageInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LanguageInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LanguageInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LanguageInfoValidationError) ErrorName() string { return "LanguageInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LanguageInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLanguageInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LanguageInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LanguageInfoValidationError{}
+
+// Validate checks the field values on Topic with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Topic) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Topic with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TopicMultiError, or nil if none found.
+func (m *Topic) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Topic) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisplayName
+
+	if len(errors) > 0 {
+		return TopicMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopicMultiError is an error wrapping multiple validation errors returned by
+// Topic.ValidateAll() if the designated constraints aren't met.
+type TopicMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopicMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopicMultiError) AllErrors() []error { return m }
+
+// TopicValidationError is the validation error returned by Topic.Validate if
+// the designated constraints aren't met.
+type TopicValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopicValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopicValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopicValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopicValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopicValidationError) ErrorName() string { return "TopicValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TopicValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopic.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopicValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopicValidationError{}
+
 // Validate checks the field values on Question with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Question) Validate() error {
+func (m *Question) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Question with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuestionMultiError, or nil
+// if none found.
+func (m *Question) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Question) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Language
+
+	// no validation rules for Topic
+
+	// no validation rules for Difficulty
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QuestionValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QuestionValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QuestionValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Explanation
+
+	if all {
+		switch v := interface{}(m.GetDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QuestionValidationError{
+					field:  "Deadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QuestionValidationError{
+					field:  "Deadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QuestionValidationError{
+				field:  "Deadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Answer.(type) {
+	case *Question_MultipleChoice:
+		if v == nil {
+			err := QuestionValidationError{
+				field:  "Answer",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMultipleChoice()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuestionValidationError{
+						field:  "MultipleChoice",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuestionValidationError{
+						field:  "MultipleChoice",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMultipleChoice()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuestionValidationError{
+					field:  "MultipleChoice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Question_FreeText:
+		if v == nil {
+			err := QuestionValidationError{
+				field:  "Answer",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFreeText()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuestionValidationError{
+						field:  "FreeText",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuestionValidationError{
+						field:  "FreeText",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFreeText()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuestionValidationError{
+					field:  "FreeText",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return QuestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuestionMultiError is an error wrapping multiple validation errors returned
+// by Question.ValidateAll() if the designated constraints aren't met.
+type QuestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuestionMultiError) AllErrors() []error { return m }
+
+// QuestionValidationError is the validation error returned by
+// Question.Validate if the designated constraints aren't met.
+type QuestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuestionValidationError) ErrorName() string { return "QuestionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuestionValidationError{}
+
+// Validate checks the field values on ListQuestions_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQuestions_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuestions_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuestions_RequestMultiError, or nil if none found.
+func (m *ListQuestions_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuestions_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListQuestions_Request_Language_NotInLookup[m.GetLanguage()]; ok {
+		err := ListQuestions_RequestValidationError{
+			field:  "Language",
+			reason: "value must not be in list [LANGUAGE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Language_name[int32(m.GetLanguage())]; !ok {
+		err := ListQuestions_RequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTopics()) < 1 {
+		err := ListQuestions_RequestValidationError{
+			field:  "Topics",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListQuestions_Request_Difficulty_NotInLookup[m.GetDifficulty()]; ok {
+		err := ListQuestions_RequestValidationError{
+			field:  "Difficulty",
+			reason: "value must not be in list [DIFFICULTY_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Difficulty_name[int32(m.GetDifficulty())]; !ok {
+		err := ListQuestions_RequestValidationError{
+			field:  "Difficulty",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val <= 0 || val >= 10 {
+		err := ListQuestions_RequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 10)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AnswerType_name[int32(m.GetAnswerType())]; !ok {
+		err := ListQuestions_RequestValidationError{
+			field:  "AnswerType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListQuestions_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuestions_RequestMultiError is an error wrapping multiple validation
+// errors returned by ListQuestions_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ListQuestions_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuestions_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuestions_RequestMultiError) AllErrors() []error { return m }
+
+// ListQuestions_RequestValidationError is the validation error returned by
+// ListQuestions_Request.Validate if the designated constraints aren't met.
+type ListQuestions_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuestions_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuestions_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuestions_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuestions_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuestions_RequestValidationError) ErrorName() string {
+	return "ListQuestions_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuestions_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuestions_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuestions_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuestions_RequestValidationError{}
+
+var _ListQuestions_Request_Language_NotInLookup = map[Language]struct{}{
+	0: {},
+}
+
+var _ListQuestions_Request_Difficulty_NotInLookup = map[Difficulty]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListQuestions_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQuestions_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuestions_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuestions_ResponseMultiError, or nil if none found.
+func (m *ListQuestions_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuestions_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQuestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQuestions_ResponseValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQuestions_ResponseValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQuestions_ResponseValidationError{
+					field:  fmt.Sprintf("Questions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQuestions_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuestions_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListQuestions_Response.ValidateAll() if the designated
+// constraints aren't met.
+type ListQuestions_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuestions_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuestions_ResponseMultiError) AllErrors() []error { return m }
+
+// ListQuestions_ResponseValidationError is the validation error returned by
+// ListQuestions_Response.Validate if the designated constraints aren't met.
+type ListQuestions_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuestions_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuestions_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuestions_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuestions_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuestions_ResponseValidationError) ErrorName() string {
+	return "ListQuestions_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuestions_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuestions_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuestions_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuestions_ResponseValidationError{}
+
+// Validate checks the field values on SubmitAnswer_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_RequestMultiError, or nil if none found.
+func (m *SubmitAnswer_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetQuestionId()) < 1 {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "QuestionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofAnswerPresent := false
+	switch v := m.Answer.(type) {
+	case *SubmitAnswer_Request_MultipleChoice:
+		if v == nil {
+			err := SubmitAnswer_RequestValidationError{
+				field:  "Answer",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofAnswerPresent = true
+
+		if all {
+			switch v := interface{}(m.GetMultipleChoice()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmitAnswer_RequestValidationError{
+						field:  "MultipleChoice",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmitAnswer_RequestValidationError{
+						field:  "MultipleChoice",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMultipleChoice()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmitAnswer_RequestValidationError{
+					field:  "MultipleChoice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SubmitAnswer_Request_FreeText:
+		if v == nil {
+			err := SubmitAnswer_RequestValidationError{
+				field:  "Answer",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofAnswerPresent = true
+
+		if all {
+			switch v := interface{}(m.GetFreeText()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmitAnswer_RequestValidationError{
+						field:  "FreeText",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmitAnswer_RequestValidationError{
+						field:  "FreeText",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFreeText()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmitAnswer_RequestValidationError{
+					field:  "FreeText",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofAnswerPresent {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "Answer",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_RequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitAnswer_Request.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_RequestMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_RequestValidationError is the validation error returned by
+// SubmitAnswer_Request.Validate if the designated constraints aren't met.
+type SubmitAnswer_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_RequestValidationError) ErrorName() string {
+	return "SubmitAnswer_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_RequestValidationError{}
+
+// Validate checks the field values on SubmitAnswer_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_ResponseMultiError, or nil if none found.
+func (m *SubmitAnswer_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Correct
+
+	// no validation rules for Explanation
+
+	// no validation rules for Points
+
+	// no validation rules for Late
+
+	// no validation rules for SpeedBonus
+
+	if len(errors) > 0 {
+		return SubmitAnswer_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_ResponseMultiError is an error wrapping multiple validation
+// errors returned by SubmitAnswer_Response.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_ResponseMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_ResponseValidationError is the validation error returned by
+// SubmitAnswer_Response.Validate if the designated constraints aren't met.
+type SubmitAnswer_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_ResponseValidationError) ErrorName() string {
+	return "SubmitAnswer_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_ResponseValidationError{}
+
+// Validate checks the field values on
+// SubmitAnswer_Request_MultipleChoiceAnswer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Request_MultipleChoiceAnswer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SubmitAnswer_Request_MultipleChoiceAnswer with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SubmitAnswer_Request_MultipleChoiceAnswerMultiError, or nil if none found.
+func (m *SubmitAnswer_Request_MultipleChoiceAnswer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Request_MultipleChoiceAnswer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetSelectedOptions()) < 1 {
+		err := SubmitAnswer_Request_MultipleChoiceAnswerValidationError{
+			field:  "SelectedOptions",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_Request_MultipleChoiceAnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_Request_MultipleChoiceAnswerMultiError is an error wrapping
+// multiple validation errors returned by
+// SubmitAnswer_Request_MultipleChoiceAnswer.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_Request_MultipleChoiceAnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_Request_MultipleChoiceAnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_Request_MultipleChoiceAnswerMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_Request_MultipleChoiceAnswerValidationError is the validation
+// error returned by SubmitAnswer_Request_MultipleChoiceAnswer.Validate if the
+// designated constraints aren't met.
+type SubmitAnswer_Request_MultipleChoiceAnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_Request_MultipleChoiceAnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_Request_MultipleChoiceAnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_Request_MultipleChoiceAnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_Request_MultipleChoiceAnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_Request_MultipleChoiceAnswerValidationError) ErrorName() string {
+	return "SubmitAnswer_Request_MultipleChoiceAnswerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_Request_MultipleChoiceAnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Request_MultipleChoiceAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_Request_MultipleChoiceAnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_Request_MultipleChoiceAnswerValidationError{}
+
+// Validate checks the field values on SubmitAnswer_Request_FreeTextAnswer with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SubmitAnswer_Request_FreeTextAnswer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Request_FreeTextAnswer
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_Request_FreeTextAnswerMultiError, or nil if none found.
+func (m *SubmitAnswer_Request_FreeTextAnswer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Request_FreeTextAnswer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetText()) < 1 {
+		err := SubmitAnswer_Request_FreeTextAnswerValidationError{
+			field:  "Text",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_Request_FreeTextAnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_Request_FreeTextAnswerMultiError is an error wrapping multiple
+// validation errors returned by
+// SubmitAnswer_Request_FreeTextAnswer.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_Request_FreeTextAnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_Request_FreeTextAnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_Request_FreeTextAnswerMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_Request_FreeTextAnswerValidationError is the validation error
+// returned by SubmitAnswer_Request_FreeTextAnswer.Validate if the designated
+// constraints aren't met.
+type SubmitAnswer_Request_FreeTextAnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_Request_FreeTextAnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_Request_FreeTextAnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_Request_FreeTextAnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_Request_FreeTextAnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_Request_FreeTextAnswerValidationError) ErrorName() string {
+	return "SubmitAnswer_Request_FreeTextAnswerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_Request_FreeTextAnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Request_FreeTextAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_Request_FreeTextAnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_Request_FreeTextAnswerValidationError{}
+
+// Validate checks the field values on ListLanguages_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLanguages_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLanguages_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLanguages_RequestMultiError, or nil if none found.
+func (m *ListLanguages_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLanguages_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return ListLanguages_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLanguages_RequestMultiError is an error wrapping multiple validation
+// errors returned by ListLanguages_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ListLanguages_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLanguages_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLanguages_RequestMultiError) AllErrors() []error { return m }
+
+// ListLanguages_RequestValidationError is the validation error returned by
+// ListLanguages_Request.Validate if the designated constraints aren't met.
+type ListLanguages_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLanguages_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLanguages_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLanguages_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLanguages_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLanguages_RequestValidationError) ErrorName() string {
+	return "ListLanguages_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLanguages_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLanguages_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLanguages_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLanguages_RequestValidationError{}
+
+// Validate checks the field values on ListLanguages_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLanguages_Response) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Question with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in QuestionMultiError, or nil
-// if none found.
-func (m *Question) ValidateAll() error {
+// ValidateAll checks the field values on ListLanguages_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLanguages_ResponseMultiError, or nil if none found.
+func (m *ListLanguages_Response) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Question) validate(all bool) error {
+func (m *ListLanguages_Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Language
-
-	// no validation rules for Topic
-
-	// no validation rules for Difficulty
-
-	if all {
-		switch v := interface{}(m.GetContent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, QuestionValidationError{
-					field:  "Content",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, QuestionValidationError{
-					field:  "Content",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return QuestionValidationError{
-				field:  "Content",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Explanation
-
-	switch v := m.Answer.(type) {
-	case *Question_MultipleChoice:
-		if v == nil {
-			err := QuestionValidationError{
-				field:  "Answer",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMultipleChoice()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QuestionValidationError{
-						field:  "MultipleChoice",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, QuestionValidationError{
-						field:  "MultipleChoice",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMultipleChoice()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return QuestionValidationError{
-					field:  "MultipleChoice",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *Question_FreeText:
-		if v == nil {
-			err := QuestionValidationError{
-				field:  "Answer",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetLanguages() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetFreeText()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QuestionValidationError{
-						field:  "FreeText",
+					errors = append(errors, ListLanguages_ResponseValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, QuestionValidationError{
-						field:  "FreeText",
+					errors = append(errors, ListLanguages_ResponseValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetFreeText()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return QuestionValidationError{
-					field:  "FreeText",
+				return ListLanguages_ResponseValidationError{
+					field:  fmt.Sprintf("Languages[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return QuestionMultiError(errors)
+		return ListLanguages_ResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// QuestionMultiError is an error wrapping multiple validation errors returned
-// by Question.ValidateAll() if the designated constraints aren't met.
-type QuestionMultiError []error
+// ListLanguages_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLanguages_Response.ValidateAll() if the designated
+// constraints aren't met.
+type ListLanguages_ResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QuestionMultiError) Error() string {
+func (m ListLanguages_ResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -304,11 +1955,11 @@ func (m QuestionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QuestionMultiError) AllErrors() []error { return m }
+func (m ListLanguages_ResponseMultiError) AllErrors() []error { return m }
 
-// QuestionValidationError is the validation error returned by
-// Question.Validate if the designated constraints aren't met.
-type QuestionValidationError struct {
+// ListLanguages_ResponseValidationError is the validation error returned by
+// ListLanguages_Response.Validate if the designated constraints aren't met.
+type ListLanguages_ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -316,22 +1967,24 @@ type QuestionValidationError struct {
 }
 
 // Field function returns field value.
-func (e QuestionValidationError) Field() string { return e.field }
+func (e ListLanguages_ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QuestionValidationError) Reason() string { return e.reason }
+func (e ListLanguages_ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QuestionValidationError) Cause() error { return e.cause }
+func (e ListLanguages_ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QuestionValidationError) Key() bool { return e.key }
+func (e ListLanguages_ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QuestionValidationError) ErrorName() string { return "QuestionValidationError" }
+func (e ListLanguages_ResponseValidationError) ErrorName() string {
+	return "ListLanguages_ResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e QuestionValidationError) Error() string {
+func (e ListLanguages_ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -343,14 +1996,14 @@ func (e QuestionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQuestion.%s: %s%s",
+		"invalid %sListLanguages_Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QuestionValidationError{}
+var _ error = ListLanguages_ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -358,32 +2011,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QuestionValidationError{}
+} = ListLanguages_ResponseValidationError{}
 
-// Validate checks the field values on ListQuestions_Request with the rules
+// Validate checks the field values on ListTopics_Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListQuestions_Request) Validate() error {
+func (m *ListTopics_Request) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListQuestions_Request with the rules
+// ValidateAll checks the field values on ListTopics_Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListQuestions_RequestMultiError, or nil if none found.
-func (m *ListQuestions_Request) ValidateAll() error {
+// ListTopics_RequestMultiError, or nil if none found.
+func (m *ListTopics_Request) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListQuestions_Request) validate(all bool) error {
+func (m *ListTopics_Request) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if _, ok := _ListQuestions_Request_Language_NotInLookup[m.GetLanguage()]; ok {
-		err := ListQuestions_RequestValidationError{
+	if _, ok := _ListTopics_Request_Language_NotInLookup[m.GetLanguage()]; ok {
+		err := ListTopics_RequestValidationError{
 			field:  "Language",
 			reason: "value must not be in list [LANGUAGE_UNSPECIFIED]",
 		}
@@ -394,7 +2047,7 @@ func (m *ListQuestions_Request) validate(all bool) error {
 	}
 
 	if _, ok := Language_name[int32(m.GetLanguage())]; !ok {
-		err := ListQuestions_RequestValidationError{
+		err := ListTopics_RequestValidationError{
 			field:  "Language",
 			reason: "value must be one of the defined enum values",
 		}
@@ -404,64 +2057,22 @@ func (m *ListQuestions_Request) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetTopics()) < 1 {
-		err := ListQuestions_RequestValidationError{
-			field:  "Topics",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _ListQuestions_Request_Difficulty_NotInLookup[m.GetDifficulty()]; ok {
-		err := ListQuestions_RequestValidationError{
-			field:  "Difficulty",
-			reason: "value must not be in list [DIFFICULTY_UNSPECIFIED]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := Difficulty_name[int32(m.GetDifficulty())]; !ok {
-		err := ListQuestions_RequestValidationError{
-			field:  "Difficulty",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if val := m.GetLimit(); val <= 0 || val >= 10 {
-		err := ListQuestions_RequestValidationError{
-			field:  "Limit",
-			reason: "value must be inside range (0, 10)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Locale
 
 	if len(errors) > 0 {
-		return ListQuestions_RequestMultiError(errors)
+		return ListTopics_RequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListQuestions_RequestMultiError is an error wrapping multiple validation
-// errors returned by ListQuestions_Request.ValidateAll() if the designated
-// constraints aren't met.
-type ListQuestions_RequestMultiError []error
+// ListTopics_RequestMultiError is an error wrapping multiple validation errors
+// returned by ListTopics_Request.ValidateAll() if the designated constraints
+// aren't met.
+type ListTopics_RequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListQuestions_RequestMultiError) Error() string {
+func (m ListTopics_RequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -470,11 +2081,11 @@ func (m ListQuestions_RequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListQuestions_RequestMultiError) AllErrors() []error { return m }
+func (m ListTopics_RequestMultiError) AllErrors() []error { return m }
 
-// ListQuestions_RequestValidationError is the validation error returned by
-// ListQuestions_Request.Validate if the designated constraints aren't met.
-type ListQuestions_RequestValidationError struct {
+// ListTopics_RequestValidationError is the validation error returned by
+// ListTopics_Request.Validate if the designated constraints aren't met.
+type ListTopics_RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -482,24 +2093,24 @@ type ListQuestions_RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListQuestions_RequestValidationError) Field() string { return e.field }
+func (e ListTopics_RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListQuestions_RequestValidationError) Reason() string { return e.reason }
+func (e ListTopics_RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListQuestions_RequestValidationError) Cause() error { return e.cause }
+func (e ListTopics_RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListQuestions_RequestValidationError) Key() bool { return e.key }
+func (e ListTopics_RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListQuestions_RequestValidationError) ErrorName() string {
-	return "ListQuestions_RequestValidationError"
+func (e ListTopics_RequestValidationError) ErrorName() string {
+	return "ListTopics_RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListQuestions_RequestValidationError) Error() string {
+func (e ListTopics_RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -511,14 +2122,14 @@ func (e ListQuestions_RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListQuestions_Request.%s: %s%s",
+		"invalid %sListTopics_Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListQuestions_RequestValidationError{}
+var _ error = ListTopics_RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -526,55 +2137,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListQuestions_RequestValidationError{}
-
-var _ListQuestions_Request_Language_NotInLookup = map[Language]struct{}{
-	0: {},
-}
+} = ListTopics_RequestValidationError{}
 
-var _ListQuestions_Request_Difficulty_NotInLookup = map[Difficulty]struct{}{
+var _ListTopics_Request_Language_NotInLookup = map[Language]struct{}{
 	0: {},
 }
 
-// Validate checks the field values on ListQuestions_Response with the rules
+// Validate checks the field values on ListTopics_Response with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListQuestions_Response) Validate() error {
+func (m *ListTopics_Response) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListQuestions_Response with the rules
+// ValidateAll checks the field values on ListTopics_Response with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListQuestions_ResponseMultiError, or nil if none found.
-func (m *ListQuestions_Response) ValidateAll() error {
+// ListTopics_ResponseMultiError, or nil if none found.
+func (m *ListTopics_Response) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListQuestions_Response) validate(all bool) error {
+func (m *ListTopics_Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetQuestions() {
+	for idx, item := range m.GetTopics() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListQuestions_ResponseValidationError{
-						field:  fmt.Sprintf("Questions[%v]", idx),
+					errors = append(errors, ListTopics_ResponseValidationError{
+						field:  fmt.Sprintf("Topics[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListQuestions_ResponseValidationError{
-						field:  fmt.Sprintf("Questions[%v]", idx),
+					errors = append(errors, ListTopics_ResponseValidationError{
+						field:  fmt.Sprintf("Topics[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -582,8 +2189,8 @@ func (m *ListQuestions_Response) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListQuestions_ResponseValidationError{
-					field:  fmt.Sprintf("Questions[%v]", idx),
+				return ListTopics_ResponseValidationError{
+					field:  fmt.Sprintf("Topics[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -593,19 +2200,19 @@ func (m *ListQuestions_Response) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListQuestions_ResponseMultiError(errors)
+		return ListTopics_ResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListQuestions_ResponseMultiError is an error wrapping multiple validation
-// errors returned by ListQuestions_Response.ValidateAll() if the designated
+// ListTopics_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTopics_Response.ValidateAll() if the designated
 // constraints aren't met.
-type ListQuestions_ResponseMultiError []error
+type ListTopics_ResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListQuestions_ResponseMultiError) Error() string {
+func (m ListTopics_ResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -614,11 +2221,11 @@ func (m ListQuestions_ResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListQuestions_ResponseMultiError) AllErrors() []error { return m }
+func (m ListTopics_ResponseMultiError) AllErrors() []error { return m }
 
-// ListQuestions_ResponseValidationError is the validation error returned by
-// ListQuestions_Response.Validate if the designated constraints aren't met.
-type ListQuestions_ResponseValidationError struct {
+// ListTopics_ResponseValidationError is the validation error returned by
+// ListTopics_Response.Validate if the designated constraints aren't met.
+type ListTopics_ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -626,24 +2233,24 @@ type ListQuestions_ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListQuestions_ResponseValidationError) Field() string { return e.field }
+func (e ListTopics_ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListQuestions_ResponseValidationError) Reason() string { return e.reason }
+func (e ListTopics_ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListQuestions_ResponseValidationError) Cause() error { return e.cause }
+func (e ListTopics_ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListQuestions_ResponseValidationError) Key() bool { return e.key }
+func (e ListTopics_ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListQuestions_ResponseValidationError) ErrorName() string {
-	return "ListQuestions_ResponseValidationError"
+func (e ListTopics_ResponseValidationError) ErrorName() string {
+	return "ListTopics_ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListQuestions_ResponseValidationError) Error() string {
+func (e ListTopics_ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -655,14 +2262,14 @@ func (e ListQuestions_ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListQuestions_Response.%s: %s%s",
+		"invalid %sListTopics_Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListQuestions_ResponseValidationError{}
+var _ error = ListTopics_ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -670,7 +2277,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListQuestions_ResponseValidationError{}
+} = ListTopics_ResponseValidationError{}
 
 // Validate checks the field values on Question_Content with the rules defined
 // in the proto definition for this message. If any rules are violated, the
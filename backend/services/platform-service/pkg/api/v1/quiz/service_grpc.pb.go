@@ -23,6 +23,9 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QuizClient interface {
 	ListQuestions(ctx context.Context, in *ListQuestions_Request, opts ...grpc.CallOption) (*ListQuestions_Response, error)
+	SubmitAnswer(ctx context.Context, in *SubmitAnswer_Request, opts ...grpc.CallOption) (*SubmitAnswer_Response, error)
+	ListLanguages(ctx context.Context, in *ListLanguages_Request, opts ...grpc.CallOption) (*ListLanguages_Response, error)
+	ListTopics(ctx context.Context, in *ListTopics_Request, opts ...grpc.CallOption) (*ListTopics_Response, error)
 }
 
 type quizClient struct {
@@ -42,11 +45,41 @@ func (c *quizClient) ListQuestions(ctx context.Context, in *ListQuestions_Reques
 	return out, nil
 }
 
+func (c *quizClient) SubmitAnswer(ctx context.Context, in *SubmitAnswer_Request, opts ...grpc.CallOption) (*SubmitAnswer_Response, error) {
+	out := new(SubmitAnswer_Response)
+	err := c.cc.Invoke(ctx, "/quiz.Quiz/SubmitAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizClient) ListLanguages(ctx context.Context, in *ListLanguages_Request, opts ...grpc.CallOption) (*ListLanguages_Response, error) {
+	out := new(ListLanguages_Response)
+	err := c.cc.Invoke(ctx, "/quiz.Quiz/ListLanguages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizClient) ListTopics(ctx context.Context, in *ListTopics_Request, opts ...grpc.CallOption) (*ListTopics_Response, error) {
+	out := new(ListTopics_Response)
+	err := c.cc.Invoke(ctx, "/quiz.Quiz/ListTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuizServer is the server API for Quiz service.
 // All implementations must embed UnimplementedQuizServer
 // for forward compatibility
 type QuizServer interface {
 	ListQuestions(context.Context, *ListQuestions_Request) (*ListQuestions_Response, error)
+	SubmitAnswer(context.Context, *SubmitAnswer_Request) (*SubmitAnswer_Response, error)
+	ListLanguages(context.Context, *ListLanguages_Request) (*ListLanguages_Response, error)
+	ListTopics(context.Context, *ListTopics_Request) (*ListTopics_Response, error)
 	mustEmbedUnimplementedQuizServer()
 }
 
@@ -57,6 +90,15 @@ type UnimplementedQuizServer struct {
 func (UnimplementedQuizServer) ListQuestions(context.Context, *ListQuestions_Request) (*ListQuestions_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListQuestions not implemented")
 }
+func (UnimplementedQuizServer) SubmitAnswer(context.Context, *SubmitAnswer_Request) (*SubmitAnswer_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnswer not implemented")
+}
+func (UnimplementedQuizServer) ListLanguages(context.Context, *ListLanguages_Request) (*ListLanguages_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLanguages not implemented")
+}
+func (UnimplementedQuizServer) ListTopics(context.Context, *ListTopics_Request) (*ListTopics_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+}
 func (UnimplementedQuizServer) mustEmbedUnimplementedQuizServer() {}
 
 // UnsafeQuizServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +130,60 @@ func _Quiz_ListQuestions_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Quiz_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAnswer_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServer).SubmitAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quiz.Quiz/SubmitAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServer).SubmitAnswer(ctx, req.(*SubmitAnswer_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quiz_ListLanguages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLanguages_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServer).ListLanguages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quiz.Quiz/ListLanguages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServer).ListLanguages(ctx, req.(*ListLanguages_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quiz_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopics_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quiz.Quiz/ListTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServer).ListTopics(ctx, req.(*ListTopics_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Quiz_ServiceDesc is the grpc.ServiceDesc for Quiz service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +195,18 @@ var Quiz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListQuestions",
 			Handler:    _Quiz_ListQuestions_Handler,
 		},
+		{
+			MethodName: "SubmitAnswer",
+			Handler:    _Quiz_SubmitAnswer_Handler,
+		},
+		{
+			MethodName: "ListLanguages",
+			Handler:    _Quiz_ListLanguages_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _Quiz_ListTopics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/quiz/service.proto",
@@ -0,0 +1,1714 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/v1/session/service.proto
+
+package session
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	quiz "github.com/casnerano/snippet-war/pkg/api/v1/quiz"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = quiz.Language(0)
+)
+
+// Validate checks the field values on StartSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StartSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StartSessionMultiError, or
+// nil if none found.
+func (m *StartSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StartSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartSessionMultiError is an error wrapping multiple validation errors
+// returned by StartSession.ValidateAll() if the designated constraints aren't met.
+type StartSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartSessionMultiError) AllErrors() []error { return m }
+
+// StartSessionValidationError is the validation error returned by
+// StartSession.Validate if the designated constraints aren't met.
+type StartSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartSessionValidationError) ErrorName() string { return "StartSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StartSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartSessionValidationError{}
+
+// Validate checks the field values on NextQuestion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NextQuestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NextQuestion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NextQuestionMultiError, or
+// nil if none found.
+func (m *NextQuestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NextQuestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return NextQuestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// NextQuestionMultiError is an error wrapping multiple validation errors
+// returned by NextQuestion.ValidateAll() if the designated constraints aren't met.
+type NextQuestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NextQuestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NextQuestionMultiError) AllErrors() []error { return m }
+
+// NextQuestionValidationError is the validation error returned by
+// NextQuestion.Validate if the designated constraints aren't met.
+type NextQuestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NextQuestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NextQuestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NextQuestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NextQuestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NextQuestionValidationError) ErrorName() string { return "NextQuestionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NextQuestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNextQuestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NextQuestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NextQuestionValidationError{}
+
+// Validate checks the field values on SubmitAnswer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubmitAnswerMultiError, or
+// nil if none found.
+func (m *SubmitAnswer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SubmitAnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswerMultiError is an error wrapping multiple validation errors
+// returned by SubmitAnswer.ValidateAll() if the designated constraints aren't met.
+type SubmitAnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswerMultiError) AllErrors() []error { return m }
+
+// SubmitAnswerValidationError is the validation error returned by
+// SubmitAnswer.Validate if the designated constraints aren't met.
+type SubmitAnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswerValidationError) ErrorName() string { return "SubmitAnswerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswerValidationError{}
+
+// Validate checks the field values on FinishSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FinishSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FinishSessionMultiError, or
+// nil if none found.
+func (m *FinishSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FinishSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishSessionMultiError is an error wrapping multiple validation errors
+// returned by FinishSession.ValidateAll() if the designated constraints
+// aren't met.
+type FinishSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishSessionMultiError) AllErrors() []error { return m }
+
+// FinishSessionValidationError is the validation error returned by
+// FinishSession.Validate if the designated constraints aren't met.
+type FinishSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishSessionValidationError) ErrorName() string { return "FinishSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FinishSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishSessionValidationError{}
+
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Language
+
+	// no validation rules for Difficulty
+
+	// no validation rules for TotalQuestions
+
+	// no validation rules for AnsweredQuestions
+
+	// no validation rules for CorrectAnswers
+
+	// no validation rules for Score
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AnswerType
+
+	if len(errors) > 0 {
+		return SessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionMultiError) AllErrors() []error { return m }
+
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionValidationError{}
+
+// Validate checks the field values on StartSession_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartSession_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartSession_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartSession_RequestMultiError, or nil if none found.
+func (m *StartSession_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartSession_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetQuestions() == nil {
+		err := StartSession_RequestValidationError{
+			field:  "Questions",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuestions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartSession_RequestValidationError{
+					field:  "Questions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartSession_RequestValidationError{
+					field:  "Questions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuestions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartSession_RequestValidationError{
+				field:  "Questions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartSession_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartSession_RequestMultiError is an error wrapping multiple validation
+// errors returned by StartSession_Request.ValidateAll() if the designated
+// constraints aren't met.
+type StartSession_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartSession_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartSession_RequestMultiError) AllErrors() []error { return m }
+
+// StartSession_RequestValidationError is the validation error returned by
+// StartSession_Request.Validate if the designated constraints aren't met.
+type StartSession_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartSession_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartSession_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartSession_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartSession_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartSession_RequestValidationError) ErrorName() string {
+	return "StartSession_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartSession_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartSession_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartSession_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartSession_RequestValidationError{}
+
+// Validate checks the field values on StartSession_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartSession_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartSession_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartSession_ResponseMultiError, or nil if none found.
+func (m *StartSession_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartSession_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSession()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartSession_ResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartSession_ResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartSession_ResponseValidationError{
+				field:  "Session",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartSession_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartSession_ResponseMultiError is an error wrapping multiple validation
+// errors returned by StartSession_Response.ValidateAll() if the designated
+// constraints aren't met.
+type StartSession_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartSession_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartSession_ResponseMultiError) AllErrors() []error { return m }
+
+// StartSession_ResponseValidationError is the validation error returned by
+// StartSession_Response.Validate if the designated constraints aren't met.
+type StartSession_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartSession_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartSession_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartSession_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartSession_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartSession_ResponseValidationError) ErrorName() string {
+	return "StartSession_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartSession_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartSession_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartSession_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartSession_ResponseValidationError{}
+
+// Validate checks the field values on NextQuestion_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NextQuestion_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NextQuestion_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NextQuestion_RequestMultiError, or nil if none found.
+func (m *NextQuestion_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NextQuestion_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionId()) < 1 {
+		err := NextQuestion_RequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NextQuestion_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// NextQuestion_RequestMultiError is an error wrapping multiple validation
+// errors returned by NextQuestion_Request.ValidateAll() if the designated
+// constraints aren't met.
+type NextQuestion_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NextQuestion_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NextQuestion_RequestMultiError) AllErrors() []error { return m }
+
+// NextQuestion_RequestValidationError is the validation error returned by
+// NextQuestion_Request.Validate if the designated constraints aren't met.
+type NextQuestion_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NextQuestion_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NextQuestion_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NextQuestion_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NextQuestion_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NextQuestion_RequestValidationError) ErrorName() string {
+	return "NextQuestion_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NextQuestion_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNextQuestion_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NextQuestion_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NextQuestion_RequestValidationError{}
+
+// Validate checks the field values on NextQuestion_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NextQuestion_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NextQuestion_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NextQuestion_ResponseMultiError, or nil if none found.
+func (m *NextQuestion_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NextQuestion_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSession()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NextQuestion_ResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NextQuestion_ResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NextQuestion_ResponseValidationError{
+				field:  "Session",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuestion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NextQuestion_ResponseValidationError{
+					field:  "Question",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NextQuestion_ResponseValidationError{
+					field:  "Question",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuestion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NextQuestion_ResponseValidationError{
+				field:  "Question",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NextQuestion_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// NextQuestion_ResponseMultiError is an error wrapping multiple validation
+// errors returned by NextQuestion_Response.ValidateAll() if the designated
+// constraints aren't met.
+type NextQuestion_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NextQuestion_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NextQuestion_ResponseMultiError) AllErrors() []error { return m }
+
+// NextQuestion_ResponseValidationError is the validation error returned by
+// NextQuestion_Response.Validate if the designated constraints aren't met.
+type NextQuestion_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NextQuestion_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NextQuestion_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NextQuestion_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NextQuestion_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NextQuestion_ResponseValidationError) ErrorName() string {
+	return "NextQuestion_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NextQuestion_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNextQuestion_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NextQuestion_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NextQuestion_ResponseValidationError{}
+
+// Validate checks the field values on SubmitAnswer_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_RequestMultiError, or nil if none found.
+func (m *SubmitAnswer_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionId()) < 1 {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnswer() == nil {
+		err := SubmitAnswer_RequestValidationError{
+			field:  "Answer",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnswer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_RequestValidationError{
+					field:  "Answer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_RequestValidationError{
+					field:  "Answer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_RequestValidationError{
+				field:  "Answer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_RequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitAnswer_Request.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_RequestMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_RequestValidationError is the validation error returned by
+// SubmitAnswer_Request.Validate if the designated constraints aren't met.
+type SubmitAnswer_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_RequestValidationError) ErrorName() string {
+	return "SubmitAnswer_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_RequestValidationError{}
+
+// Validate checks the field values on SubmitAnswer_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitAnswer_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitAnswer_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitAnswer_ResponseMultiError, or nil if none found.
+func (m *SubmitAnswer_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitAnswer_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSession()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_ResponseValidationError{
+				field:  "Session",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitAnswer_ResponseValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitAnswer_ResponseValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitAnswer_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitAnswer_ResponseMultiError is an error wrapping multiple validation
+// errors returned by SubmitAnswer_Response.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitAnswer_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitAnswer_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitAnswer_ResponseMultiError) AllErrors() []error { return m }
+
+// SubmitAnswer_ResponseValidationError is the validation error returned by
+// SubmitAnswer_Response.Validate if the designated constraints aren't met.
+type SubmitAnswer_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitAnswer_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitAnswer_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitAnswer_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitAnswer_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitAnswer_ResponseValidationError) ErrorName() string {
+	return "SubmitAnswer_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitAnswer_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitAnswer_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitAnswer_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitAnswer_ResponseValidationError{}
+
+// Validate checks the field values on FinishSession_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishSession_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishSession_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishSession_RequestMultiError, or nil if none found.
+func (m *FinishSession_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishSession_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionId()) < 1 {
+		err := FinishSession_RequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FinishSession_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishSession_RequestMultiError is an error wrapping multiple validation
+// errors returned by FinishSession_Request.ValidateAll() if the designated
+// constraints aren't met.
+type FinishSession_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishSession_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishSession_RequestMultiError) AllErrors() []error { return m }
+
+// FinishSession_RequestValidationError is the validation error returned by
+// FinishSession_Request.Validate if the designated constraints aren't met.
+type FinishSession_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishSession_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishSession_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishSession_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishSession_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishSession_RequestValidationError) ErrorName() string {
+	return "FinishSession_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishSession_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishSession_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishSession_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishSession_RequestValidationError{}
+
+// Validate checks the field values on FinishSession_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishSession_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishSession_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishSession_ResponseMultiError, or nil if none found.
+func (m *FinishSession_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishSession_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSession()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishSession_ResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishSession_ResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishSession_ResponseValidationError{
+				field:  "Session",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FinishSession_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishSession_ResponseMultiError is an error wrapping multiple validation
+// errors returned by FinishSession_Response.ValidateAll() if the designated
+// constraints aren't met.
+type FinishSession_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishSession_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishSession_ResponseMultiError) AllErrors() []error { return m }
+
+// FinishSession_ResponseValidationError is the validation error returned by
+// FinishSession_Response.Validate if the designated constraints aren't met.
+type FinishSession_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishSession_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishSession_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishSession_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishSession_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishSession_ResponseValidationError) ErrorName() string {
+	return "FinishSession_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishSession_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishSession_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishSession_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishSession_ResponseValidationError{}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.32.0
+// source: api/v1/session/service.proto
+
+package session
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GameSessionClient is the client API for GameSession service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameSessionClient interface {
+	StartSession(ctx context.Context, in *StartSession_Request, opts ...grpc.CallOption) (*StartSession_Response, error)
+	NextQuestion(ctx context.Context, in *NextQuestion_Request, opts ...grpc.CallOption) (*NextQuestion_Response, error)
+	SubmitAnswer(ctx context.Context, in *SubmitAnswer_Request, opts ...grpc.CallOption) (*SubmitAnswer_Response, error)
+	FinishSession(ctx context.Context, in *FinishSession_Request, opts ...grpc.CallOption) (*FinishSession_Response, error)
+}
+
+type gameSessionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameSessionClient(cc grpc.ClientConnInterface) GameSessionClient {
+	return &gameSessionClient{cc}
+}
+
+func (c *gameSessionClient) StartSession(ctx context.Context, in *StartSession_Request, opts ...grpc.CallOption) (*StartSession_Response, error) {
+	out := new(StartSession_Response)
+	err := c.cc.Invoke(ctx, "/session.GameSession/StartSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameSessionClient) NextQuestion(ctx context.Context, in *NextQuestion_Request, opts ...grpc.CallOption) (*NextQuestion_Response, error) {
+	out := new(NextQuestion_Response)
+	err := c.cc.Invoke(ctx, "/session.GameSession/NextQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameSessionClient) SubmitAnswer(ctx context.Context, in *SubmitAnswer_Request, opts ...grpc.CallOption) (*SubmitAnswer_Response, error) {
+	out := new(SubmitAnswer_Response)
+	err := c.cc.Invoke(ctx, "/session.GameSession/SubmitAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameSessionClient) FinishSession(ctx context.Context, in *FinishSession_Request, opts ...grpc.CallOption) (*FinishSession_Response, error) {
+	out := new(FinishSession_Response)
+	err := c.cc.Invoke(ctx, "/session.GameSession/FinishSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameSessionServer is the server API for GameSession service.
+// All implementations must embed UnimplementedGameSessionServer
+// for forward compatibility
+type GameSessionServer interface {
+	StartSession(context.Context, *StartSession_Request) (*StartSession_Response, error)
+	NextQuestion(context.Context, *NextQuestion_Request) (*NextQuestion_Response, error)
+	SubmitAnswer(context.Context, *SubmitAnswer_Request) (*SubmitAnswer_Response, error)
+	FinishSession(context.Context, *FinishSession_Request) (*FinishSession_Response, error)
+	mustEmbedUnimplementedGameSessionServer()
+}
+
+// UnimplementedGameSessionServer must be embedded to have forward compatible implementations.
+type UnimplementedGameSessionServer struct {
+}
+
+func (UnimplementedGameSessionServer) StartSession(context.Context, *StartSession_Request) (*StartSession_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSession not implemented")
+}
+func (UnimplementedGameSessionServer) NextQuestion(context.Context, *NextQuestion_Request) (*NextQuestion_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextQuestion not implemented")
+}
+func (UnimplementedGameSessionServer) SubmitAnswer(context.Context, *SubmitAnswer_Request) (*SubmitAnswer_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAnswer not implemented")
+}
+func (UnimplementedGameSessionServer) FinishSession(context.Context, *FinishSession_Request) (*FinishSession_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishSession not implemented")
+}
+func (UnimplementedGameSessionServer) mustEmbedUnimplementedGameSessionServer() {}
+
+// UnsafeGameSessionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameSessionServer will
+// result in compilation errors.
+type UnsafeGameSessionServer interface {
+	mustEmbedUnimplementedGameSessionServer()
+}
+
+func RegisterGameSessionServer(s grpc.ServiceRegistrar, srv GameSessionServer) {
+	s.RegisterService(&GameSession_ServiceDesc, srv)
+}
+
+func _GameSession_StartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSession_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameSessionServer).StartSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.GameSession/StartSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameSessionServer).StartSession(ctx, req.(*StartSession_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameSession_NextQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextQuestion_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameSessionServer).NextQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.GameSession/NextQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameSessionServer).NextQuestion(ctx, req.(*NextQuestion_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameSession_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAnswer_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameSessionServer).SubmitAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.GameSession/SubmitAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameSessionServer).SubmitAnswer(ctx, req.(*SubmitAnswer_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameSession_FinishSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishSession_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameSessionServer).FinishSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.GameSession/FinishSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameSessionServer).FinishSession(ctx, req.(*FinishSession_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameSession_ServiceDesc is the grpc.ServiceDesc for GameSession service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameSession_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "session.GameSession",
+	HandlerType: (*GameSessionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartSession",
+			Handler:    _GameSession_StartSession_Handler,
+		},
+		{
+			MethodName: "NextQuestion",
+			Handler:    _GameSession_NextQuestion_Handler,
+		},
+		{
+			MethodName: "SubmitAnswer",
+			Handler:    _GameSession_SubmitAnswer_Handler,
+		},
+		{
+			MethodName: "FinishSession",
+			Handler:    _GameSession_FinishSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/session/service.proto",
+}